@@ -6,16 +6,28 @@
 package ca
 
 import (
+	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ct"
+	"github.com/letsencrypt/boulder/lint"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/policy"
 
@@ -44,8 +56,46 @@ type Config struct {
 	// The maximum number of subjectAltNames in a single certificate
 	MaxNames int
 	CFSSL    cfsslConfig.Config
+	// The issuers certificates can be issued from. If empty, the CA issues
+	// from a single issuer, named DefaultIssuerName, using Key and the
+	// issuer certificate it is constructed with.
+	Issuers []IssuerConfig
+	// The CT logs precertificates are submitted to. If there are none, no
+	// precertificates are issued and certificates have no embedded SCTs.
+	CT ct.Config
+	// Named issuance profiles the RA can choose from, besides the default
+	// one, which is named after Profile and issues for Expiry.
+	Profiles map[string]ProfileConfig
 }
 
+// ProfileConfig defines a named issuance profile.
+type ProfileConfig struct {
+	// The CFSSL signing profile certificates are signed with, which sets
+	// their key usages, extensions and policy OIDs
+	CFSSLProfile string
+	// How long certificates are valid for, should match expiry field in the
+	// CFSSL profile.
+	Expiry string
+}
+
+// IssuerConfig defines one of the issuers the CA can issue certificates from.
+type IssuerConfig struct {
+	Name string
+	// Path to a PEM-encoded copy of the issuer certificate
+	CertFile string
+	Key      KeyConfig
+	// The public key types ("RSA" or "ECDSA") of the certificates this
+	// issuer is used for. If empty, it is used for any key type.
+	KeyTypes []string
+	// A retired issuer no longer issues certificates, but still signs OCSP
+	// responses for the ones it issued.
+	Retired bool
+}
+
+// DefaultIssuerName is the name of the issuer used when the CA has no
+// issuers configured
+const DefaultIssuerName = "default"
+
 // KeyConfig should contain either a File path to a PEM-format private key,
 // or a PKCS11Config defining how to load a module for an HSM.
 type KeyConfig struct {
@@ -61,19 +111,271 @@ type PKCS11Config struct {
 	Label  string
 }
 
+// Issuer is an issuer certificate and the signers that use its key.
+type Issuer struct {
+	Name       string
+	Cert       *x509.Certificate
+	OCSPSigner ocsp.Signer
+	// The algorithm the issuer's key signs with
+	SignatureAlgorithm x509.SignatureAlgorithm
+	// Certificates issued from this issuer must expire before NotAfter
+	NotAfter time.Time
+	KeyTypes []string
+	Retired  bool
+
+	key            crypto.Signer
+	templateSigner signer.Signer
+	// The throwaway key and certificate the template signer uses, with which
+	// certificates are also signed to be linted
+	templateKey  crypto.Signer
+	templateCert *x509.Certificate
+}
+
+// NewIssuer creates an Issuer that signs certificates and OCSP responses
+// for the issuer certificate with its private key, using the signature
+// algorithm that suits the key.
+func NewIssuer(name string, cert *x509.Certificate, priv crypto.Signer, policy *cfsslConfig.Signing, lifespanOCSP time.Duration) (*Issuer, error) {
+	sigAlg, err := core.SignatureAlgorithmForKey(priv.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	// Set up our OCSP signer. Note this calls for both the issuer cert and the
+	// OCSP signing cert, which are the same in our case. The OCSP signer
+	// chooses the same signature algorithm for the key as the certificate
+	// signer, since SignatureAlgorithmForKey only accepts keys for which the
+	// two agree.
+	ocspSigner, err := ocsp.NewSigner(cert, cert, priv, lifespanOCSP)
+	if err != nil {
+		return nil, err
+	}
+
+	templateKey, templateCert, err := newTemplateIssuer(cert)
+	if err != nil {
+		return nil, err
+	}
+	templateSigner, err := local.NewSigner(templateKey, templateCert, x509.ECDSAWithSHA256, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Issuer{
+		Name:               name,
+		Cert:               cert,
+		OCSPSigner:         ocspSigner,
+		SignatureAlgorithm: sigAlg,
+		NotAfter:           cert.NotAfter,
+		key:                priv,
+		templateSigner:     templateSigner,
+		templateKey:        templateKey,
+		templateCert:       templateCert,
+	}, nil
+}
+
+// newTemplateIssuer creates a throwaway key and issuer certificate that
+// nothing trusts, with the same subject and key identifier as the issuer
+// certificate. The certificates CFSSL signs with them are templates for the
+// precertificates and certificates the issuer key then signs directly.
+func newTemplateIssuer(cert *x509.Certificate) (*ecdsa.PrivateKey, *x509.Certificate, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          cert.SerialNumber,
+		RawSubject:            cert.RawSubject,
+		SubjectKeyId:          cert.SubjectKeyId,
+		NotBefore:             cert.NotBefore,
+		NotAfter:              cert.NotAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	throwawayDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	throwaway, err := x509.ParseCertificate(throwawayDER)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, throwaway, nil
+}
+
+// signLinted signs the template with the issuer key, once the CA's lints
+// pass on a certificate signed from the very same template by the issuer's
+// throwaway template key. The two certificates only differ in their
+// signatures, so a certificate that breaks the Baseline Requirements is never
+// signed by the issuer. Lint warnings are only logged.
+func (ca *CertificateAuthorityImpl) signLinted(issuer *Issuer, template *x509.Certificate) ([]byte, error) {
+	lintTemplate := *template
+	lintTemplate.SignatureAlgorithm = x509.UnknownSignatureAlgorithm
+	lintDER, err := x509.CreateCertificate(rand.Reader, &lintTemplate, issuer.templateCert, template.PublicKey, issuer.templateKey)
+	if err != nil {
+		return nil, err
+	}
+	lintCert, err := x509.ParseCertificate(lintDER)
+	if err != nil {
+		return nil, err
+	}
+
+	serial := core.SerialToString(template.SerialNumber)
+	results := lint.Check(lintCert, ca.Lints)
+	for _, result := range results {
+		if result.Level == lint.Warning {
+			ca.log.Warning(fmt.Sprintf("Lint warning: serial=[%s] %s", serial, result))
+		}
+	}
+	if err = results.Err(); err != nil {
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		ca.log.Audit(fmt.Sprintf("Lint failed, aborting issuance: serial=[%s] err=[%v]", serial, err))
+		return nil, err
+	}
+
+	return x509.CreateCertificate(rand.Reader, template, issuer.Cert, template.PublicKey, issuer.key)
+}
+
+// signFromTemplate signs a certificate for a request with the issuer key
+// directly, from a template signed by the issuer's template signer, so that
+// it can carry extensions the CFSSL signer doesn't add, and so that exactly
+// what the issuer key signs is linted first.
+//
+// With CT logs configured, it first issues a precertificate, submits it to
+// the logs and embeds the SCTs they return in the certificate. Both are
+// signed from the same template, so they only differ in the poison and SCT
+// list extensions.
+func (ca *CertificateAuthorityImpl) signFromTemplate(issuer *Issuer, req signer.SignRequest, extensions []pkix.Extension) ([]byte, []core.SignedCertificateTimestamp, error) {
+	templatePEM, err := issuer.templateSigner.Sign(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(templatePEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil, errors.New("Invalid certificate template returned")
+	}
+	template, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Only the issuer certificate determines these
+	template.AuthorityKeyId = nil
+	template.SignatureAlgorithm = issuer.SignatureAlgorithm
+	// CreateCertificate regenerates extensions from the parsed fields, which
+	// don't capture all of them: policy qualifiers, for one, would be lost.
+	// So the extensions CFSSL signed are carried over as they are, after
+	// those the request adds.
+	extensions = append(append([]pkix.Extension{}, extensions...), profileExtensions(template)...)
+
+	if ca.CT == nil {
+		template.ExtraExtensions = extensions
+		certDER, err := ca.signLinted(issuer, template)
+		if err != nil {
+			return nil, nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), nil, nil
+	}
+
+	template.ExtraExtensions = append(append([]pkix.Extension{}, extensions...), ct.PoisonExtension)
+	precertDER, err := ca.signLinted(issuer, template)
+	if err != nil {
+		return nil, nil, err
+	}
+	// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+	ca.log.Audit(fmt.Sprintf("Issued precertificate: serial=[%s] precert=[%s]", core.SerialToString(template.SerialNumber), hex.EncodeToString(precertDER)))
+
+	scts, err := ca.CT.SubmitPrecertificate(precertDER, issuer.Cert)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sctList, err := ct.SCTListExtension(scts)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.ExtraExtensions = append(append([]pkix.Extension{}, extensions...), sctList)
+	certDER, err := ca.signLinted(issuer, template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Make sure the SCTs are valid for the certificate as it was signed,
+	// not just for the precertificate
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = ca.CT.VerifyEmbeddedSCTs(scts, cert, issuer.Cert); err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), scts, nil
+}
+
+// oidAuthorityKeyIdentifier identifies the authority key identifier
+// extension (RFC 5280, section 4.2.1.1).
+var oidAuthorityKeyIdentifier = asn1.ObjectIdentifier{2, 5, 29, 35}
+
+// profileExtensions returns the extensions of a certificate signed by the
+// template signer that the issuer's certificate should carry verbatim: all
+// but the authority key identifier, which names the throwaway issuer.
+func profileExtensions(template *x509.Certificate) []pkix.Extension {
+	var extensions []pkix.Extension
+	for _, ext := range template.Extensions {
+		if !ext.Id.Equal(oidAuthorityKeyIdentifier) {
+			extensions = append(extensions, ext)
+		}
+	}
+	return extensions
+}
+
+// issues returns true if the issuer issues certificates for the key type
+func (issuer *Issuer) issues(keyType string) bool {
+	if issuer.Retired {
+		return false
+	}
+	if len(issuer.KeyTypes) == 0 {
+		return true
+	}
+	for _, t := range issuer.KeyTypes {
+		if strings.EqualFold(t, keyType) {
+			return true
+		}
+	}
+	return false
+}
+
+// issued returns true if the certificate names this issuer as its issuer,
+// both by name and, where the certificates carry them, by key identifier.
+func (issuer *Issuer) issued(cert *x509.Certificate) bool {
+	if !bytes.Equal(cert.RawIssuer, issuer.Cert.RawSubject) {
+		return false
+	}
+	if len(cert.AuthorityKeyId) > 0 && len(issuer.Cert.SubjectKeyId) > 0 {
+		return bytes.Equal(cert.AuthorityKeyId, issuer.Cert.SubjectKeyId)
+	}
+	return true
+}
+
+// Profile is a named set of parameters that certificates are issued with.
+type Profile struct {
+	Name           string
+	CFSSLProfile   string
+	ValidityPeriod time.Duration
+}
+
 // CertificateAuthorityImpl represents a CA that signs certificates, CRLs, and
 // OCSP responses.
 type CertificateAuthorityImpl struct {
-	profile        string
-	Signer         signer.Signer
-	OCSPSigner     ocsp.Signer
+	// Issuance profiles by name, and the one used when none is asked for
+	Profiles       map[string]*Profile
+	DefaultProfile string
+	Issuers        []*Issuer
+	CT             *ct.Submitter
+	Lints          []lint.Lint
 	SA             core.StorageAuthority
 	PA             core.PolicyAuthority
 	DB             core.CertificateAuthorityDatabase
 	log            *blog.AuditLogger
 	Prefix         int // Prepended to the serial number
-	ValidityPeriod time.Duration
-	NotAfter       time.Time
 	MaxNames       int
 	MaxKeySize     int
 }
@@ -82,8 +384,8 @@ type CertificateAuthorityImpl struct {
 // instance.  (To use a local signer, simply instantiate CertificateAuthorityImpl
 // directly.)  Communications with the CA are authenticated with MACs,
 // using CFSSL's authenticated signature scheme.  A CA created in this way
-// issues for a single profile on the remote signer, which is indicated
-// by name in this constructor.
+// issues for the profile on the remote signer named in the config by default,
+// and for any of the named issuance profiles on request.
 func NewCertificateAuthorityImpl(cadb core.CertificateAuthorityDatabase, config Config, issuerCert string) (*CertificateAuthorityImpl, error) {
 	var ca *CertificateAuthorityImpl
 	var err error
@@ -105,21 +407,12 @@ func NewCertificateAuthorityImpl(cadb core.CertificateAuthorityDatabase, config
 	if err != nil {
 		return nil, err
 	}
-
-	// Load the private key, which can be a file or a PKCS#11 key.
-	priv, err := loadKey(config.Key)
-	if err != nil {
-		return nil, err
-	}
-
-	issuer, err := loadIssuer(issuerCert)
-	if err != nil {
-		return nil, err
-	}
-
-	signer, err := local.NewSigner(priv, issuer, x509.SHA256WithRSA, cfsslConfigObj.Signing)
-	if err != nil {
-		return nil, err
+	// The serial numbers the CA picks are only used by the signer if the
+	// profile asks for them.
+	if cfsslConfigObj.Signing != nil {
+		for _, profile := range cfsslConfigObj.Signing.Profiles {
+			profile.UseSerialSeq = true
+		}
 	}
 
 	if config.LifespanOCSP == "" {
@@ -130,40 +423,96 @@ func NewCertificateAuthorityImpl(cadb core.CertificateAuthorityDatabase, config
 		return nil, err
 	}
 
-	// Set up our OCSP signer. Note this calls for both the issuer cert and the
-	// OCSP signing cert, which are the same in our case.
-	ocspSigner, err := ocsp.NewSigner(issuer, issuer, priv, lifespanOCSP)
-	if err != nil {
-		return nil, err
+	issuerConfigs := config.Issuers
+	if len(issuerConfigs) == 0 {
+		issuerConfigs = []IssuerConfig{IssuerConfig{
+			Name:     DefaultIssuerName,
+			CertFile: issuerCert,
+			Key:      config.Key,
+		}}
+	}
+
+	var issuers []*Issuer
+	for _, issuerConfig := range issuerConfigs {
+		// Load the private key, which can be a file or a PKCS#11 key.
+		priv, err := LoadKey(issuerConfig.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		cert, err := loadIssuer(issuerConfig.CertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		issuer, err := NewIssuer(issuerConfig.Name, cert, priv, cfsslConfigObj.Signing, lifespanOCSP)
+		if err != nil {
+			return nil, fmt.Errorf("Could not create issuer %s: %s", issuerConfig.Name, err)
+		}
+		issuer.KeyTypes = issuerConfig.KeyTypes
+		issuer.Retired = issuerConfig.Retired
+		issuers = append(issuers, issuer)
+		logger.Info(fmt.Sprintf("Loaded issuer %s [%s, %v]", issuer.Name, cert.Subject.CommonName, issuer.SignatureAlgorithm))
 	}
 
 	pa := policy.NewPolicyAuthorityImpl()
 
 	ca = &CertificateAuthorityImpl{
-		Signer:     signer,
-		OCSPSigner: ocspSigner,
-		profile:    config.Profile,
-		PA:         pa,
-		DB:         cadb,
-		Prefix:     config.SerialPrefix,
-		log:        logger,
-		NotAfter:   issuer.NotAfter,
+		Issuers: issuers,
+		Lints:   lint.NewLints(pa.IsPublicName),
+		PA:      pa,
+		DB:      cadb,
+		Prefix:  config.SerialPrefix,
+		log:     logger,
 	}
 
 	if config.Expiry == "" {
 		return nil, errors.New("Config must specify an expiry period.")
 	}
-	ca.ValidityPeriod, err = time.ParseDuration(config.Expiry)
+	validityPeriod, err := time.ParseDuration(config.Expiry)
 	if err != nil {
 		return nil, err
 	}
+	ca.DefaultProfile = config.Profile
+	ca.Profiles = map[string]*Profile{
+		config.Profile: &Profile{
+			Name:           config.Profile,
+			CFSSLProfile:   config.Profile,
+			ValidityPeriod: validityPeriod,
+		},
+	}
+	for name, profileConfig := range config.Profiles {
+		if cfsslConfigObj.Signing == nil || cfsslConfigObj.Signing.Profiles[profileConfig.CFSSLProfile] == nil {
+			return nil, fmt.Errorf("Issuance profile %s uses unknown CFSSL profile %s", name, profileConfig.CFSSLProfile)
+		}
+		if profileConfig.Expiry == "" {
+			return nil, fmt.Errorf("Issuance profile %s must specify an expiry period.", name)
+		}
+		validityPeriod, err := time.ParseDuration(profileConfig.Expiry)
+		if err != nil {
+			return nil, err
+		}
+		ca.Profiles[name] = &Profile{
+			Name:           name,
+			CFSSLProfile:   profileConfig.CFSSLProfile,
+			ValidityPeriod: validityPeriod,
+		}
+		logger.Info(fmt.Sprintf("Loaded issuance profile %s [%s, %s]", name, profileConfig.CFSSLProfile, validityPeriod))
+	}
 
 	ca.MaxNames = config.MaxNames
 
+	ca.CT, err = ct.NewSubmitter(config.CT)
+	if err != nil {
+		return nil, err
+	}
+
 	return ca, nil
 }
 
-func loadKey(keyConfig KeyConfig) (priv crypto.Signer, err error) {
+// LoadKey loads the private key described by a KeyConfig, from a file or from
+// a PKCS#11 token.
+func LoadKey(keyConfig KeyConfig) (priv crypto.Signer, err error) {
 	if keyConfig.File != "" {
 		var keyBytes []byte
 		keyBytes, err = ioutil.ReadFile(keyConfig.File)
@@ -208,6 +557,87 @@ func loadIssuerKey(filename string) (issuerKey crypto.Signer, err error) {
 	return
 }
 
+// keyType names the type of a public key for matching against issuers'
+// KeyTypes
+func keyType(key crypto.PublicKey) string {
+	switch key.(type) {
+	case *rsa.PublicKey, rsa.PublicKey:
+		return "RSA"
+	case *ecdsa.PublicKey, ecdsa.PublicKey:
+		return "ECDSA"
+	}
+	return "unknown"
+}
+
+// issuerForKey selects the first issuer that issues certificates for the
+// type of the key and doesn't expire before notAfter, so that issuance moves
+// on to the next issuer while the first is being retired.
+func (ca *CertificateAuthorityImpl) issuerForKey(key crypto.PublicKey, notAfter time.Time) (*Issuer, error) {
+	t := keyType(key)
+	expiring := false
+	for _, issuer := range ca.Issuers {
+		if !issuer.issues(t) {
+			continue
+		}
+		if issuer.NotAfter.Before(notAfter) {
+			expiring = true
+			continue
+		}
+		return issuer, nil
+	}
+	if expiring {
+		return nil, errors.New("Cannot issue a certificate that expires after the intermediate certificate.")
+	}
+	return nil, fmt.Errorf("No issuer available for %s keys", t)
+}
+
+// issuerForCert finds the issuer that issued a certificate.
+func (ca *CertificateAuthorityImpl) issuerForCert(cert *x509.Certificate) (*Issuer, error) {
+	for _, issuer := range ca.Issuers {
+		if issuer.issued(cert) {
+			return issuer, nil
+		}
+	}
+	return nil, fmt.Errorf("No issuer found for certificate %s issued by %s", core.SerialToString(cert.SerialNumber), cert.Issuer.CommonName)
+}
+
+// serialRandomBytes is how much CSPRNG output follows the instance prefix in
+// the serial numbers the CA picks. The signer appends a further 63 random bits.
+const serialRandomBytes = 8
+
+// maxSerialAttempts bounds how many random serials are tried before issuance
+// fails, in the unlikely event that each of them is already in use.
+const maxSerialAttempts = 3
+
+// newSerial picks the prefix of a new serial number: the instance prefix
+// followed by serialRandomBytes of random data, checked against the SA for
+// uniqueness.  The prefix must fit in one byte so that every serial has the
+// same length, including on CAs that weren't made by
+// NewCertificateAuthorityImpl.
+func (ca *CertificateAuthorityImpl) newSerial() (string, error) {
+	if ca.Prefix <= 0 || ca.Prefix >= 256 {
+		return "", fmt.Errorf("Serial prefix %d is not between 1 and 255", ca.Prefix)
+	}
+	for i := 0; i < maxSerialAttempts; i++ {
+		random := make([]byte, serialRandomBytes)
+		if _, err := rand.Read(random); err != nil {
+			return "", err
+		}
+		serialHex := fmt.Sprintf("%02x%x", ca.Prefix, random)
+
+		_, err := ca.SA.GetCertificateByShortSerial(serialHex)
+		if err == nil {
+			ca.log.Warning(fmt.Sprintf("Serial %s already in use, trying another", serialHex))
+			continue
+		}
+		if _, ok := err.(core.NotFoundError); !ok {
+			return "", err
+		}
+		return serialHex, nil
+	}
+	return "", errors.New("Could not find an unused serial number")
+}
+
 // GenerateOCSP produces a new OCSP response and returns it
 func (ca *CertificateAuthorityImpl) GenerateOCSP(xferObj core.OCSPSigningRequest) ([]byte, error) {
 	cert, err := x509.ParseCertificate(xferObj.CertDER)
@@ -217,6 +647,13 @@ func (ca *CertificateAuthorityImpl) GenerateOCSP(xferObj core.OCSPSigningRequest
 		return nil, err
 	}
 
+	issuer, err := ca.issuerForCert(cert)
+	if err != nil {
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		ca.log.AuditErr(err)
+		return nil, err
+	}
+
 	signRequest := ocsp.SignRequest{
 		Certificate: cert,
 		Status:      xferObj.Status,
@@ -224,10 +661,67 @@ func (ca *CertificateAuthorityImpl) GenerateOCSP(xferObj core.OCSPSigningRequest
 		RevokedAt:   xferObj.RevokedAt,
 	}
 
-	ocspResponse, err := ca.OCSPSigner.Sign(signRequest)
+	ocspResponse, err := issuer.OCSPSigner.Sign(signRequest)
 	return ocspResponse, err
 }
 
+// GenerateCRL signs a CRL with the named issuer, which may be retired, listing
+// the revoked certificates in the request.
+func (ca *CertificateAuthorityImpl) GenerateCRL(xferObj core.CRLSigningRequest) ([]byte, error) {
+	var issuer *Issuer
+	for _, i := range ca.Issuers {
+		if i.Name == xferObj.Issuer {
+			issuer = i
+		}
+	}
+	if issuer == nil {
+		err := fmt.Errorf("Unknown issuer %q", xferObj.Issuer)
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		ca.log.AuditErr(err)
+		return nil, err
+	}
+
+	if !xferObj.NextUpdate.After(xferObj.ThisUpdate) {
+		err := core.MalformedRequestError(fmt.Sprintf("NextUpdate %s is not after ThisUpdate %s", xferObj.NextUpdate, xferObj.ThisUpdate))
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		ca.log.AuditErr(err)
+		return nil, err
+	}
+
+	entries := make([]x509.RevocationListEntry, len(xferObj.RevokedCerts))
+	for i, revoked := range xferObj.RevokedCerts {
+		serial, err := core.StringToSerial(revoked.Serial)
+		if err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			ca.log.AuditErr(err)
+			return nil, err
+		}
+		entries[i] = x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: revoked.RevokedAt,
+			ReasonCode:     revoked.Reason,
+		}
+	}
+
+	template := &x509.RevocationList{
+		SignatureAlgorithm:        issuer.SignatureAlgorithm,
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(xferObj.Number),
+		ThisUpdate:                xferObj.ThisUpdate,
+		NextUpdate:                xferObj.NextUpdate,
+	}
+	crl, err := x509.CreateRevocationList(rand.Reader, template, issuer.Cert, issuer.key)
+	if err != nil {
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		ca.log.AuditErr(err)
+		return nil, err
+	}
+
+	ca.log.Info(fmt.Sprintf("Signed CRL: issuer=[%s] number=[%d] entries=[%d] nextUpdate=[%s]",
+		issuer.Name, xferObj.Number, len(entries), xferObj.NextUpdate))
+	return crl, nil
+}
+
 // RevokeCertificate revokes the trust of the Cert referred to by the provided Serial.
 func (ca *CertificateAuthorityImpl) RevokeCertificate(serial string, reasonCode int) (err error) {
 	coreCert, err := ca.SA.GetCertificate(serial)
@@ -243,13 +737,20 @@ func (ca *CertificateAuthorityImpl) RevokeCertificate(serial string, reasonCode
 		return err
 	}
 
+	issuer, err := ca.issuerForCert(cert)
+	if err != nil {
+		// AUDIT[ Revocation Requests ] 4e85d791-09c0-4ab3-a837-d3d67e945134
+		ca.log.AuditErr(err)
+		return err
+	}
+
 	signRequest := ocsp.SignRequest{
 		Certificate: cert,
 		Status:      string(core.OCSPStatusRevoked),
 		Reason:      reasonCode,
 		RevokedAt:   time.Now(),
 	}
-	ocspResponse, err := ca.OCSPSigner.Sign(signRequest)
+	ocspResponse, err := issuer.OCSPSigner.Sign(signRequest)
 	if err != nil {
 		// AUDIT[ Revocation Requests ] 4e85d791-09c0-4ab3-a837-d3d67e945134
 		ca.log.AuditErr(err)
@@ -260,8 +761,9 @@ func (ca *CertificateAuthorityImpl) RevokeCertificate(serial string, reasonCode
 }
 
 // IssueCertificate attempts to convert a CSR into a signed Certificate, while
-// enforcing all policies.
-func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time) (core.Certificate, error) {
+// enforcing all policies. The certificate is issued with the named issuance
+// profile, or the default one if the name is empty.
+func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time, profileName string) (core.Certificate, error) {
 	emptyCert := core.Certificate{}
 	var err error
 	key, ok := csr.PublicKey.(crypto.PublicKey)
@@ -278,11 +780,15 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		return emptyCert, err
 	}
 
-	// Pull hostnames from CSR
+	// Pull hostnames and IP addresses from CSR. CFSSL places any host that
+	// parses as an IP address into the IP SANs.
 	// Authorization is checked by the RA
 	commonName := ""
 	hostNames := make([]string, len(csr.DNSNames))
 	copy(hostNames, csr.DNSNames)
+	for _, ip := range csr.IPAddresses {
+		hostNames = append(hostNames, ip.String())
+	}
 	if len(csr.Subject.CommonName) > 0 {
 		commonName = csr.Subject.CommonName
 		hostNames = append(hostNames, csr.Subject.CommonName)
@@ -304,7 +810,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 	}
 
 	// Verify that names are allowed by policy
-	identifier := core.AcmeIdentifier{Type: core.IdentifierDNS, Value: commonName}
+	identifier := core.IdentifierForName(commonName)
 	if err = ca.PA.WillingToIssue(identifier); err != nil {
 		err = fmt.Errorf("Policy forbids issuing for name %s", commonName)
 		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
@@ -312,7 +818,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		return emptyCert, err
 	}
 	for _, name := range hostNames {
-		identifier = core.AcmeIdentifier{Type: core.IdentifierDNS, Value: name}
+		identifier = core.IdentifierForName(name)
 		if err = ca.PA.WillingToIssue(identifier); err != nil {
 			err = fmt.Errorf("Policy forbids issuing for name %s", name)
 			// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
@@ -321,20 +827,44 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		}
 	}
 
-	notAfter := time.Now().Add(ca.ValidityPeriod)
+	if profileName == "" {
+		profileName = ca.DefaultProfile
+	}
+	profile, ok := ca.Profiles[profileName]
+	if !ok {
+		err = fmt.Errorf("Unknown issuance profile %s", profileName)
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		ca.log.AuditErr(err)
+		return emptyCert, err
+	}
+
+	notAfter := time.Now().Add(profile.ValidityPeriod)
 
-	if ca.NotAfter.Before(notAfter) {
+	issuer, err := ca.issuerForKey(key, notAfter)
+	if err != nil {
 		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
-		err = errors.New("Cannot issue a certificate that expires after the intermediate certificate.")
 		ca.log.AuditErr(err)
 		return emptyCert, err
 	}
 
-	// Note: We do not current enforce that certificate lifetimes match
-	// authorization lifetimes, because it was breaking integration tests.
 	if earliestExpiry.Before(notAfter) {
-		message := fmt.Sprintf("Issuing a certificate that expires after the shortest underlying authorization. [%v] [%v]", earliestExpiry, notAfter)
-		ca.log.Notice(message)
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		err = errors.New("Cannot issue a certificate that expires after the shortest underlying authorization.")
+		ca.log.AuditErr(err)
+		return emptyCert, err
+	}
+
+	// The RA only passes on CSRs asking for OCSP Must-Staple from
+	// registrations allowed it.
+	var extensions []pkix.Extension
+	mustStaple, err := core.RequestsMustStaple(&csr)
+	if err != nil {
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		ca.log.AuditErr(err)
+		return emptyCert, err
+	}
+	if mustStaple {
+		extensions = append(extensions, core.MustStapleExtension)
 	}
 
 	// Convert the CSR to PEM
@@ -343,27 +873,18 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		Bytes: csr.Raw,
 	}))
 
-	// Get the next serial number
-	tx, err := ca.DB.Begin()
+	// Pick a random serial number that isn't in use yet
+	serialHex, err := ca.newSerial()
 	if err != nil {
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.AuditErr(err)
+		ca.log.Audit(fmt.Sprintf("Serial generation failed: err=[%v]", err))
 		return emptyCert, err
 	}
 
-	serialDec, err := ca.DB.IncrementAndGetSerial(tx)
-	if err != nil {
-		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("Serial increment failed, rolling back: err=[%v]", err))
-		tx.Rollback()
-		return emptyCert, err
-	}
-	serialHex := fmt.Sprintf("%02X%014X", ca.Prefix, serialDec)
-
 	// Send the cert off for signing
 	req := signer.SignRequest{
 		Request: csrPEM,
-		Profile: ca.profile,
+		Profile: profile.CFSSLProfile,
 		Hosts:   hostNames,
 		Subject: &signer.Subject{
 			CN: commonName,
@@ -371,19 +892,19 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		SerialSeq: serialHex,
 	}
 
-	certPEM, err := ca.Signer.Sign(req)
+	// With CT logs configured, the certificate is signed only once enough of
+	// them have logged its precertificate.
+	certPEM, scts, err := ca.signFromTemplate(issuer, req, extensions)
 	if err != nil {
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("Signer failed, rolling back: serial=[%s] err=[%v]", serialHex, err))
-		tx.Rollback()
+		ca.log.Audit(fmt.Sprintf("Signer failed: serial=[%s] err=[%v]", serialHex, err))
 		return emptyCert, err
 	}
 
 	if len(certPEM) == 0 {
 		err = fmt.Errorf("No certificate returned by server")
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("PEM empty from Signer, rolling back: serial=[%s] err=[%v]", serialHex, err))
-		tx.Rollback()
+		ca.log.Audit(fmt.Sprintf("PEM empty from Signer: serial=[%s] err=[%v]", serialHex, err))
 		return emptyCert, err
 	}
 
@@ -392,38 +913,39 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		err = fmt.Errorf("Invalid certificate value returned")
 
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("PEM decode error, aborting and rolling back issuance: pem=[%s] err=[%v]", certPEM, err))
-		tx.Rollback()
+		ca.log.Audit(fmt.Sprintf("PEM decode error, aborting issuance: pem=[%s] err=[%v]", certPEM, err))
 		return emptyCert, err
 	}
 	certDER := block.Bytes
 
 	cert := core.Certificate{
-		DER:    certDER,
-		Status: core.StatusValid,
+		DER:     certDER,
+		Status:  core.StatusValid,
+		Profile: profile.Name,
 	}
 
 	// This is one last check for uncaught errors
 	if err != nil {
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("Uncaught error, aborting and rolling back issuance: pem=[%s] err=[%v]", certPEM, err))
-		tx.Rollback()
+		ca.log.Audit(fmt.Sprintf("Uncaught error, aborting issuance: pem=[%s] err=[%v]", certPEM, err))
 		return emptyCert, err
 	}
 
 	// Store the cert with the certificate authority, if provided
-	_, err = ca.SA.AddCertificate(certDER, regID)
+	_, err = ca.SA.AddCertificate(certDER, regID, profile.Name)
 	if err != nil {
 		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
 		ca.log.Audit(fmt.Sprintf("Failed RPC to store at SA, orphaning certificate: pem=[%s] err=[%v]", certPEM, err))
-		tx.Rollback()
 		return emptyCert, err
 	}
 
-	if err = tx.Commit(); err != nil {
-		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
-		ca.log.Audit(fmt.Sprintf("Failed to commit, orphaning certificate: pem=[%s] err=[%v]", certPEM, err))
-		return emptyCert, err
+	// The SCTs are already embedded in the certificate, so failing to store
+	// them doesn't constitute an issuance failure either.
+	for _, sct := range scts {
+		if err = ca.SA.AddSCTReceipt(sct); err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			ca.log.Audit(fmt.Sprintf("Failed RPC to store SCT at SA: serial=[%s] log=[%s] err=[%v]", sct.CertificateSerial, sct.LogID, err))
+		}
 	}
 
 	// Attempt to generate the OCSP Response now. If this raises an error, it is
@@ -443,7 +965,7 @@ func (ca *CertificateAuthorityImpl) IssueCertificate(csr x509.CertificateRequest
 		Status:      string(core.OCSPStatusGood),
 	}
 
-	ocspResponse, err := ca.OCSPSigner.Sign(signRequest)
+	ocspResponse, err := issuer.OCSPSigner.Sign(signRequest)
 	if err != nil {
 		ca.log.Warning(fmt.Sprintf("Post-Issuance OCSP failed signing: %s", err))
 		return cert, nil
@@ -7,20 +7,38 @@ package ca
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"net"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	cfsslConfig "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/config"
 	ocspConfig "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/ocsp/config"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/signer"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/signer/local"
 	_ "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/mattn/go-sqlite3"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/golang.org/x/crypto/ocsp"
 
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ct"
+	"github.com/letsencrypt/boulder/lint"
 	"github.com/letsencrypt/boulder/sa"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -283,6 +301,8 @@ var FarPast = time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)
 const profileName = "ee"
 const caKeyFile = "../test/test-ca.key"
 const caCertFile = "../test/test-ca.pem"
+const ecdsaCAKeyFile = "../test/test-ca-ecdsa.key"
+const ecdsaCACertFile = "../test/test-ca-ecdsa.pem"
 
 func TestMain(m *testing.M) {
 
@@ -323,6 +343,11 @@ func setup(t *testing.T) (cadb core.CertificateAuthorityDatabase, storageAuthori
 							cfsslConfig.CertificatePolicy{
 								ID: cfsslConfig.OID(asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}),
 							},
+							cfsslConfig.CertificatePolicy{
+								ID:        cfsslConfig.OID(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 44947, 1, 1, 1}),
+								Type:      "id-qt-cps",
+								Qualifier: "http://not-example.com/cps",
+							},
 						},
 						ExpiryString: "8760h",
 						Backdate:     time.Hour,
@@ -352,6 +377,11 @@ func TestFailNoSerial(t *testing.T) {
 	caConfig.SerialPrefix = 0
 	_, err := NewCertificateAuthorityImpl(cadb, caConfig, caCertFile)
 	test.AssertError(t, err, "CA should have failed with no SerialPrefix")
+
+	// Prefixes that don't fit in one byte would change the serial length
+	caConfig.SerialPrefix = 256
+	_, err = NewCertificateAuthorityImpl(cadb, caConfig, caCertFile)
+	test.AssertError(t, err, "CA should have failed with a SerialPrefix over 255")
 }
 
 func TestRevoke(t *testing.T) {
@@ -366,7 +396,7 @@ func TestRevoke(t *testing.T) {
 
 	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture)
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
 	test.AssertNotError(t, err, "Failed to sign certificate")
 	if err != nil {
 		return
@@ -407,7 +437,7 @@ func TestIssueCertificate(t *testing.T) {
 		csr, _ := x509.ParseCertificateRequest(csrDER)
 
 		// Sign CSR
-		issuedCert, err := ca.IssueCertificate(*csr, 1, FarFuture)
+		issuedCert, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
 		test.AssertNotError(t, err, "Failed to sign certificate")
 		if err != nil {
 			continue
@@ -461,6 +491,45 @@ func TestIssueCertificate(t *testing.T) {
 	}
 }
 
+func TestIssueCertificateIP(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, caCertFile)
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "not-example.com"},
+		DNSNames:    []string{"not-example.com"},
+		IPAddresses: []net.IP{net.ParseIP("93.184.216.34")},
+	}, key)
+	test.AssertNotError(t, err, "Failed to create CSR")
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+
+	issuedCert, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	if err != nil {
+		return
+	}
+	cert, err := x509.ParseCertificate(issuedCert.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertEquals(t, len(cert.DNSNames), 1)
+	test.AssertEquals(t, len(cert.IPAddresses), 1)
+	test.AssertEquals(t, cert.IPAddresses[0].String(), "93.184.216.34")
+
+	// Reserved addresses are refused by policy
+	csrDER, err = x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     pkix.Name{CommonName: "not-example.com"},
+		IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+	}, key)
+	test.AssertNotError(t, err, "Failed to create CSR")
+	csr, _ = x509.ParseCertificateRequest(csrDER)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertError(t, err, "Issued certificate for a reserved IP address")
+}
+
 func TestRejectNoName(t *testing.T) {
 	cadb, storageAuthority, caConfig := setup(t)
 	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, caCertFile)
@@ -471,7 +540,7 @@ func TestRejectNoName(t *testing.T) {
 	// Test that the CA rejects CSRs with no names
 	csrDER, _ := hex.DecodeString(NoNameCSRhex)
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	_, err = ca.IssueCertificate(*csr, 1, FarFuture)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
 	if err == nil {
 		t.Errorf("CA improperly agreed to create a certificate with no name")
 	}
@@ -486,7 +555,7 @@ func TestRejectTooManyNames(t *testing.T) {
 	// Test that the CA rejects a CSR with too many names
 	csrDER, _ := hex.DecodeString(TooManyNameCSRhex)
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	_, err = ca.IssueCertificate(*csr, 1, FarFuture)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
 	test.Assert(t, err != nil, "Issued certificate with too many names")
 }
 
@@ -500,7 +569,7 @@ func TestDeduplication(t *testing.T) {
 	// Test that the CA collapses duplicate names
 	csrDER, _ := hex.DecodeString(DupeNameCSRhex)
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	cert, err := ca.IssueCertificate(*csr, 1, FarFuture)
+	cert, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
 	test.AssertNotError(t, err, "Failed to gracefully handle a CSR with duplicate names")
 	if err != nil {
 		return
@@ -526,17 +595,18 @@ func TestRejectValidityTooLong(t *testing.T) {
 	ca.SA = storageAuthority
 	ca.MaxKeySize = 4096
 
-	// Test that the CA rejects CSRs that would expire after the intermediate cert
+	// Test that the CA rejects CSRs that would expire after the underlying
+	// authorizations
 	csrDER, _ := hex.DecodeString(NoCNCSRhex)
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	_, err = ca.IssueCertificate(*csr, 1, FarPast)
-	test.Assert(t, err == nil, "Can issue a certificate that expires after the underlying authorization.")
+	_, err = ca.IssueCertificate(*csr, 1, FarPast, "")
+	test.AssertEquals(t, err.Error(), "Cannot issue a certificate that expires after the shortest underlying authorization.")
 
 	// Test that the CA rejects CSRs that would expire after the intermediate cert
 	csrDER, _ = hex.DecodeString(NoCNCSRhex)
 	csr, _ = x509.ParseCertificateRequest(csrDER)
-	ca.NotAfter = time.Now()
-	_, err = ca.IssueCertificate(*csr, 1, FarFuture)
+	ca.Issuers[0].NotAfter = time.Now()
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
 	test.AssertEquals(t, err.Error(), "Cannot issue a certificate that expires after the intermediate certificate.")
 }
 
@@ -551,8 +621,606 @@ func TestShortKey(t *testing.T) {
 		t.Errorf("Failed to read shortkey-csr.der")
 	}
 	csr, _ := x509.ParseCertificateRequest(csrDER)
-	_, err = ca.IssueCertificate(*csr, 1, FarFuture)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
 	if err == nil {
 		t.Errorf("CA improperly created a certificate with short key.")
 	}
 }
+
+// newTestIssuer creates an issuer with a fresh key and self-signed
+// certificate, so that tests don't depend on the lifetime of the test CA.
+func newTestIssuer(t *testing.T, caConfig Config, name string, keyID []byte, priv crypto.Signer) *Issuer {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test issuer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          keyID,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, priv.Public(), priv)
+	test.AssertNotError(t, err, "Failed to create issuer certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "Failed to parse issuer certificate")
+
+	cfsslJSON, err := json.Marshal(caConfig.CFSSL)
+	test.AssertNotError(t, err, "Failed to marshal CFSSL config")
+	cfsslConfigObj, err := cfsslConfig.LoadConfig(cfsslJSON)
+	test.AssertNotError(t, err, "Failed to load CFSSL config")
+
+	issuer, err := NewIssuer(name, cert, priv, cfsslConfigObj.Signing, time.Hour)
+	test.AssertNotError(t, err, "Failed to create issuer")
+	return issuer
+}
+
+func TestMultipleIssuers(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, caCertFile)
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+
+	// The old and new issuers share a name, as they would in a rollover of
+	// an intermediate's key
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	oldIssuer := newTestIssuer(t, caConfig, "old", []byte{1}, oldKey)
+	newIssuer := newTestIssuer(t, caConfig, "new", []byte{2}, newKey)
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+
+	ca.Issuers = []*Issuer{oldIssuer}
+	oldCertObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	oldCert, err := x509.ParseCertificate(oldCertObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertNotError(t, oldCert.CheckSignatureFrom(oldIssuer.Cert), "Certificate not signed by old issuer")
+
+	// After the rollover, certificates come from the new issuer
+	oldIssuer.Retired = true
+	ca.Issuers = []*Issuer{oldIssuer, newIssuer}
+	newCertObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	newCert, err := x509.ParseCertificate(newCertObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertNotError(t, newCert.CheckSignatureFrom(newIssuer.Cert), "Certificate not signed by new issuer")
+
+	// OCSP responses are signed by each certificate's own issuer
+	for _, pair := range []struct {
+		cert   *x509.Certificate
+		issuer *Issuer
+	}{{oldCert, oldIssuer}, {newCert, newIssuer}} {
+		ocspResp, err := ca.GenerateOCSP(core.OCSPSigningRequest{
+			CertDER: pair.cert.Raw,
+			Status:  string(core.OCSPStatusGood),
+		})
+		test.AssertNotError(t, err, "Failed to generate OCSP response")
+		parsed, err := ocsp.ParseResponse(ocspResp, pair.issuer.Cert)
+		test.AssertNotError(t, err, "OCSP response not signed by the certificate's issuer")
+		test.AssertEquals(t, parsed.SerialNumber.Cmp(pair.cert.SerialNumber), 0)
+	}
+	err = ca.RevokeCertificate(core.SerialToString(oldCert.SerialNumber), 0)
+	test.AssertNotError(t, err, "Failed to revoke certificate from retired issuer")
+
+	// Certificates from unknown issuers can't get OCSP responses
+	ca.Issuers = []*Issuer{newIssuer}
+	_, err = ca.GenerateOCSP(core.OCSPSigningRequest{
+		CertDER: oldCert.Raw,
+		Status:  string(core.OCSPStatusGood),
+	})
+	test.AssertError(t, err, "Generated OCSP for a certificate from an unknown issuer")
+
+	// Issuers are chosen by the key type of the request
+	newIssuer.KeyTypes = []string{"ECDSA"}
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertEquals(t, err.Error(), "No issuer available for RSA keys")
+	oldIssuer.Retired = false
+	oldIssuer.KeyTypes = []string{"RSA"}
+	ca.Issuers = []*Issuer{newIssuer, oldIssuer}
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, err := x509.ParseCertificate(certObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertNotError(t, cert.CheckSignatureFrom(oldIssuer.Cert), "Certificate not signed by RSA issuer")
+
+	// Issuers that expire before the certificate would are passed over
+	newIssuer.KeyTypes = nil
+	oldIssuer.NotAfter = time.Now().Add(time.Hour)
+	ca.Issuers = []*Issuer{oldIssuer, newIssuer}
+	certObj, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, err = x509.ParseCertificate(certObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertNotError(t, cert.CheckSignatureFrom(newIssuer.Cert), "Certificate not signed by unexpiring issuer")
+	ca.Issuers = []*Issuer{oldIssuer}
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertEquals(t, err.Error(), "Cannot issue a certificate that expires after the intermediate certificate.")
+}
+
+func TestECDSAIssuer(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA with ECDSA issuer")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+	test.AssertEquals(t, ca.Issuers[0].SignatureAlgorithm, x509.ECDSAWithSHA256)
+
+	p384Key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	p384Issuer := newTestIssuer(t, caConfig, "p384", []byte{3}, p384Key)
+	test.AssertEquals(t, p384Issuer.SignatureAlgorithm, x509.ECDSAWithSHA384)
+
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	for _, issuer := range []*Issuer{ca.Issuers[0], p384Issuer} {
+		ca.Issuers = []*Issuer{issuer}
+		certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+		test.AssertNotError(t, err, "Failed to sign certificate")
+		cert, err := x509.ParseCertificate(certObj.DER)
+		test.AssertNotError(t, err, "Certificate failed to parse")
+		test.AssertEquals(t, cert.SignatureAlgorithm, issuer.SignatureAlgorithm)
+		test.AssertNotError(t, cert.CheckSignatureFrom(issuer.Cert), "Certificate not signed by issuer")
+
+		// OCSP responses are signed with the same algorithm
+		ocspResp, err := ca.GenerateOCSP(core.OCSPSigningRequest{
+			CertDER: cert.Raw,
+			Status:  string(core.OCSPStatusGood),
+		})
+		test.AssertNotError(t, err, "Failed to generate OCSP response")
+		parsed, err := ocsp.ParseResponse(ocspResp, issuer.Cert)
+		test.AssertNotError(t, err, "Failed to parse OCSP response")
+		test.AssertEquals(t, parsed.SignatureAlgorithm, issuer.SignatureAlgorithm)
+	}
+
+	// Keys on other curves can't be issuers
+	p521Key, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	_, err = NewIssuer("p521", p384Issuer.Cert, p521Key, nil, time.Hour)
+	test.AssertError(t, err, "Created issuer with a P-521 key")
+}
+
+func TestRandomSerials(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	seen := make(map[string]bool)
+	for i := 0; i < 2; i++ {
+		certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+		test.AssertNotError(t, err, "Failed to sign certificate")
+		cert, err := x509.ParseCertificate(certObj.DER)
+		test.AssertNotError(t, err, "Certificate failed to parse")
+
+		// Instance prefix, 64 random bits from the CA, 64 from the signer
+		serial := core.SerialToString(cert.SerialNumber)
+		test.AssertEquals(t, len(serial), 34)
+		test.Assert(t, strings.HasPrefix(serial, "11"), "Serial doesn't start with the instance prefix")
+		test.Assert(t, !seen[serial], "Serial issued twice")
+		seen[serial] = true
+
+		stored, err := storageAuthority.GetCertificateByShortSerial(core.ShortSerial(cert.SerialNumber))
+		test.AssertNotError(t, err, "Couldn't find certificate by short serial")
+		test.AssertByteEquals(t, stored.DER, certObj.DER)
+	}
+
+	// If every serial tried is taken, issuance fails rather than reusing one
+	ca.SA = &usedSerialSA{storageAuthority}
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertError(t, err, "Issued certificate with a serial already in use")
+
+	// Serials are only picked with a one-byte prefix
+	ca.SA = storageAuthority
+	for _, prefix := range []int{1, 255} {
+		ca.Prefix = prefix
+		serial, err := ca.newSerial()
+		test.AssertNotError(t, err, "Failed to pick a serial")
+		test.AssertEquals(t, len(serial), 2+2*serialRandomBytes)
+	}
+	for _, prefix := range []int{0, 256, 4096} {
+		ca.Prefix = prefix
+		_, err = ca.newSerial()
+		test.AssertError(t, err, fmt.Sprintf("Picked a serial with prefix %d", prefix))
+	}
+}
+
+// usedSerialSA reports every short serial as already in use.
+type usedSerialSA struct {
+	core.StorageAuthority
+}
+
+func (sa *usedSerialSA) GetCertificateByShortSerial(string) (core.Certificate, error) {
+	return core.Certificate{}, nil
+}
+
+func TestCTPrecertificates(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	var logs []*test.MockCTLog
+	for i := 0; i < 2; i++ {
+		log := test.NewMockCTLog()
+		defer log.Close()
+		logs = append(logs, log)
+		caConfig.CT.Logs = append(caConfig.CT.Logs, ct.LogDescription{URI: log.URL, Key: log.Key})
+	}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA with CT logs")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, err := x509.ParseCertificate(certObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+	test.AssertNotError(t, cert.CheckSignatureFrom(ca.Issuers[0].Cert), "Certificate not signed by issuer")
+	// Everything the profile sets survives signing from a template
+	test.AssertEquals(t, cert.Subject.CommonName, "not-example.com")
+	sort.Strings(cert.DNSNames)
+	test.AssertDeepEquals(t, cert.DNSNames, []string{"not-example.com", "www.not-example.com"})
+	test.AssertDeepEquals(t, cert.OCSPServer, []string{"http://not-example.com/ocsp"})
+	test.AssertDeepEquals(t, cert.CRLDistributionPoints, []string{"http://not-example.com/crl"})
+	test.AssertEquals(t, len(cert.PolicyIdentifiers), 2)
+	test.AssertByteEquals(t, cert.AuthorityKeyId, ca.Issuers[0].Cert.SubjectKeyId)
+	test.AssertEquals(t, core.SerialToString(cert.SerialNumber)[:2], "11")
+	for _, log := range logs {
+		test.AssertEquals(t, log.Submissions(), 1)
+	}
+
+	// The certificate has the SCTs embedded, and no poison
+	embedded := false
+	for _, ext := range cert.Extensions {
+		test.Assert(t, !ext.Id.Equal(ct.PoisonOID), "Certificate is poisoned")
+		if ext.Id.Equal(ct.SCTListOID) {
+			embedded = true
+		}
+	}
+	test.Assert(t, embedded, "Certificate has no SCTs embedded")
+
+	scts, err := storageAuthority.GetSCTReceipts(core.SerialToString(cert.SerialNumber))
+	test.AssertNotError(t, err, "Failed to get stored SCTs")
+	test.AssertEquals(t, len(scts), 2)
+	test.AssertNotError(t, ca.CT.VerifyEmbeddedSCTs(scts, cert, ca.Issuers[0].Cert), "Embedded SCTs are invalid")
+
+	// Without enough SCTs, no certificate is issued
+	logs[0].SetFail(true)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertError(t, err, "Issued certificate without enough SCTs")
+}
+
+func TestLintBeforeIssuance(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	// Without an OCSP URL, certificates have no AIA extension
+	caConfig.CFSSL.Signing.Profiles[profileName].OCSP = ""
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertError(t, err, "Issued certificate that fails a lint")
+	test.Assert(t, strings.Contains(err.Error(), "aia"), "Error doesn't name the failed lint")
+
+	// Warnings don't stop issuance
+	ca.Lints = []lint.Lint{lint.Lint{Name: "always", Level: lint.Warning, Check: func(*x509.Certificate) error {
+		return errors.New("always fails")
+	}}}
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Lint warning stopped issuance")
+}
+
+func TestLintSignedCertificate(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	mockLog := test.NewMockCTLog()
+	defer mockLog.Close()
+	caConfig.CT.Logs = []ct.LogDescription{ct.LogDescription{URI: mockLog.URL, Key: mockLog.Key}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+	var linted []*x509.Certificate
+	ca.Lints = []lint.Lint{lint.Lint{Name: "record", Level: lint.Warning, Check: func(cert *x509.Certificate) error {
+		linted = append(linted, cert)
+		return nil
+	}}}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "not-example.com"},
+		ExtraExtensions: []pkix.Extension{core.MustStapleExtension},
+	}, key)
+	test.AssertNotError(t, err, "Failed to create CSR")
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, err := x509.ParseCertificate(certObj.DER)
+	test.AssertNotError(t, err, "Certificate failed to parse")
+
+	// Both the precertificate and the certificate are linted, and the linted
+	// certificate differs from the issued one only in its signature
+	test.AssertEquals(t, len(linted), 2)
+	poisoned := false
+	for _, ext := range linted[0].Extensions {
+		poisoned = poisoned || ext.Id.Equal(ct.PoisonOID)
+	}
+	test.Assert(t, poisoned, "Linted precertificate isn't poisoned")
+	last := linted[1]
+	test.AssertEquals(t, last.SerialNumber.Cmp(cert.SerialNumber), 0)
+	test.AssertEquals(t, last.NotBefore, cert.NotBefore)
+	test.AssertEquals(t, last.NotAfter, cert.NotAfter)
+	test.AssertByteEquals(t, last.RawIssuer, cert.RawIssuer)
+	test.AssertByteEquals(t, last.RawSubject, cert.RawSubject)
+	test.AssertByteEquals(t, last.RawSubjectPublicKeyInfo, cert.RawSubjectPublicKeyInfo)
+	test.AssertDeepEquals(t, last.Extensions, cert.Extensions)
+	present, err := core.HasMustStaple(last)
+	test.AssertNotError(t, err, "Invalid TLS Feature extension")
+	test.Assert(t, present, "Linted certificate doesn't have OCSP Must-Staple")
+}
+
+func TestProfileExtensions(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	mockLog := test.NewMockCTLog()
+	defer mockLog.Close()
+	caConfig.CT.Logs = []ct.LogDescription{ct.LogDescription{URI: mockLog.URL, Key: mockLog.Key}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+	issuer := ca.Issuers[0]
+	cfsslJSON, _ := json.Marshal(caConfig.CFSSL)
+	cfsslConfigObj, err := cfsslConfig.LoadConfig(cfsslJSON)
+	test.AssertNotError(t, err, "Failed to load CFSSL config")
+	cfsslSigner, err := local.NewSigner(issuer.key, issuer.Cert, issuer.SignatureAlgorithm, cfsslConfigObj.Signing)
+	test.AssertNotError(t, err, "Failed to create CFSSL signer")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "not-example.com"},
+		DNSNames:        []string{"not-example.com", "www.not-example.com"},
+		ExtraExtensions: []pkix.Extension{core.MustStapleExtension},
+	}, key)
+	test.AssertNotError(t, err, "Failed to create CSR")
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+
+	// Precertificates and certificates alike carry every extension of the
+	// profile exactly as CFSSL signs it, besides those the CA adds
+	for _, ctSubmitter := range []*ct.Submitter{nil, ca.CT} {
+		ca.CT = ctSubmitter
+		certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+		test.AssertNotError(t, err, "Failed to sign certificate")
+		cert, err := x509.ParseCertificate(certObj.DER)
+		test.AssertNotError(t, err, "Certificate failed to parse")
+
+		cfsslPEM, err := cfsslSigner.Sign(signer.SignRequest{
+			Request: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})),
+			Profile: profileName,
+			Hosts:   cert.DNSNames,
+			Subject: &signer.Subject{CN: cert.Subject.CommonName},
+		})
+		test.AssertNotError(t, err, "CFSSL failed to sign certificate")
+		block, _ := pem.Decode(cfsslPEM)
+		cfsslCert, err := x509.ParseCertificate(block.Bytes)
+		test.AssertNotError(t, err, "CFSSL certificate failed to parse")
+
+		issued := make(map[string]pkix.Extension)
+		for _, ext := range cert.Extensions {
+			issued[ext.Id.String()] = ext
+		}
+		for _, ext := range cfsslCert.Extensions {
+			test.AssertDeepEquals(t, issued[ext.Id.String()], ext)
+			delete(issued, ext.Id.String())
+		}
+		delete(issued, core.MustStapleExtension.Id.String())
+		delete(issued, ct.SCTListOID.String())
+		test.AssertEquals(t, len(issued), 0)
+	}
+
+	// The policy qualifiers in particular survive
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	test.Assert(t, bytes.Contains(certObj.DER, []byte("http://not-example.com/cps")), "CPS qualifier dropped")
+}
+
+func TestProfiles(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	shortLived := *caConfig.CFSSL.Signing.Profiles[profileName]
+	shortLived.ExpiryString = "24h"
+	shortLived.Policies = append([]cfsslConfig.CertificatePolicy{}, shortLived.Policies...)
+	shortLived.Policies = append(shortLived.Policies, cfsslConfig.CertificatePolicy{
+		ID: cfsslConfig.OID(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 44947, 1, 2}),
+	})
+	caConfig.CFSSL.Signing.Profiles["short"] = &shortLived
+	caConfig.Profiles = map[string]ProfileConfig{
+		"shortlived": ProfileConfig{CFSSLProfile: "short", Expiry: "24h"},
+	}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA with profiles")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+	test.AssertEquals(t, ca.DefaultProfile, profileName)
+
+	csrDER, _ := hex.DecodeString(CNandSANCSRhex)
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+	for _, name := range []string{"", profileName, "shortlived"} {
+		certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, name)
+		test.AssertNotError(t, err, "Failed to sign certificate with profile "+name)
+		cert, err := x509.ParseCertificate(certObj.DER)
+		test.AssertNotError(t, err, "Certificate failed to parse")
+
+		stored, err := storageAuthority.GetCertificate(core.SerialToString(cert.SerialNumber))
+		test.AssertNotError(t, err, "Couldn't get stored certificate")
+		validity := cert.NotAfter.Sub(cert.NotBefore)
+		if name == "shortlived" {
+			test.AssertEquals(t, certObj.Profile, "shortlived")
+			test.AssertEquals(t, stored.Profile, "shortlived")
+			test.Assert(t, validity <= 25*time.Hour, "Short-lived certificate valid for "+validity.String())
+			test.AssertEquals(t, len(cert.PolicyIdentifiers), 3)
+		} else {
+			test.AssertEquals(t, certObj.Profile, profileName)
+			test.AssertEquals(t, stored.Profile, profileName)
+			test.Assert(t, validity > 8000*time.Hour, "Default certificate valid for "+validity.String())
+			test.AssertEquals(t, len(cert.PolicyIdentifiers), 2)
+		}
+	}
+
+	_, err = ca.IssueCertificate(*csr, 1, FarFuture, "unknown")
+	test.AssertError(t, err, "Issued certificate with unknown profile")
+
+	// Profiles must name a CFSSL profile and have an expiry
+	caConfig.Profiles["broken"] = ProfileConfig{CFSSLProfile: "missing", Expiry: "24h"}
+	_, err = NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertError(t, err, "Created CA with a profile using an unknown CFSSL profile")
+	caConfig.Profiles["broken"] = ProfileConfig{CFSSLProfile: "short"}
+	_, err = NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertError(t, err, "Created CA with a profile without an expiry")
+}
+
+func TestMustStaple(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	mockLog := test.NewMockCTLog()
+	defer mockLog.Close()
+	caConfig.CT.Logs = []ct.LogDescription{ct.LogDescription{URI: mockLog.URL, Key: mockLog.Key}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+	ca.MaxKeySize = 4096
+	submitter := ca.CT
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:         pkix.Name{CommonName: "not-example.com"},
+		ExtraExtensions: []pkix.Extension{core.MustStapleExtension},
+	}, key)
+	test.AssertNotError(t, err, "Failed to create CSR")
+	csr, _ := x509.ParseCertificateRequest(csrDER)
+
+	// The extension is added whether or not precertificates are issued
+	for _, ctSubmitter := range []*ct.Submitter{nil, submitter} {
+		ca.CT = ctSubmitter
+		certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+		test.AssertNotError(t, err, "Failed to sign certificate")
+		cert, err := x509.ParseCertificate(certObj.DER)
+		test.AssertNotError(t, err, "Certificate failed to parse")
+		present, err := core.HasMustStaple(cert)
+		test.AssertNotError(t, err, "Invalid TLS Feature extension")
+		test.Assert(t, present, "Certificate doesn't have OCSP Must-Staple")
+		test.AssertNotError(t, cert.CheckSignatureFrom(ca.Issuers[0].Cert), "Certificate not signed by issuer")
+	}
+	test.AssertEquals(t, mockLog.Submissions(), 1)
+
+	// Certificates for CSRs that don't ask for it don't have it
+	csrDER, _ = hex.DecodeString(CNandSANCSRhex)
+	csr, _ = x509.ParseCertificateRequest(csrDER)
+	certObj, err := ca.IssueCertificate(*csr, 1, FarFuture, "")
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, _ := x509.ParseCertificate(certObj.DER)
+	present, _ := core.HasMustStaple(cert)
+	test.Assert(t, !present, "Certificate has OCSP Must-Staple that wasn't asked for")
+}
+
+func TestGenerateCRL(t *testing.T) {
+	cadb, storageAuthority, caConfig := setup(t)
+	caConfig.Issuers = []IssuerConfig{IssuerConfig{
+		Name:     "ecdsa",
+		CertFile: ecdsaCACertFile,
+		Key:      KeyConfig{File: ecdsaCAKeyFile},
+	}}
+	ca, err := NewCertificateAuthorityImpl(cadb, caConfig, "")
+	test.AssertNotError(t, err, "Failed to create CA")
+	ca.SA = storageAuthority
+
+	thisUpdate := time.Now().Truncate(time.Second)
+	revokedAt := thisUpdate.Add(-time.Hour)
+	req := core.CRLSigningRequest{
+		Issuer:     "ecdsa",
+		Number:     42,
+		ThisUpdate: thisUpdate,
+		NextUpdate: thisUpdate.Add(24 * time.Hour),
+		RevokedCerts: []core.RevokedCertificate{
+			core.RevokedCertificate{Serial: "0000000000000000000000000000a001", RevokedAt: revokedAt, Reason: 1},
+			core.RevokedCertificate{Serial: "0000000000000000000000000000a002", RevokedAt: revokedAt},
+		},
+	}
+	crlDER, err := ca.GenerateCRL(req)
+	test.AssertNotError(t, err, "Failed to sign CRL")
+
+	crl, err := x509.ParseRevocationList(crlDER)
+	test.AssertNotError(t, err, "CRL failed to parse")
+	test.AssertNotError(t, crl.CheckSignatureFrom(ca.Issuers[0].Cert), "CRL not signed by issuer")
+	test.AssertEquals(t, crl.Number.Int64(), int64(42))
+	test.Assert(t, crl.ThisUpdate.Equal(req.ThisUpdate), "Wrong thisUpdate")
+	test.Assert(t, crl.NextUpdate.Equal(req.NextUpdate), "Wrong nextUpdate")
+	test.AssertEquals(t, len(crl.RevokedCertificateEntries), 2)
+	entry := crl.RevokedCertificateEntries[0]
+	test.AssertEquals(t, core.SerialToString(entry.SerialNumber), "0000000000000000000000000000a001")
+	test.Assert(t, entry.RevocationTime.Equal(revokedAt), "Wrong revocation time")
+	test.AssertEquals(t, entry.ReasonCode, 1)
+	test.AssertEquals(t, crl.RevokedCertificateEntries[1].ReasonCode, 0)
+
+	// An empty CRL is still signed
+	req.RevokedCerts = nil
+	_, err = ca.GenerateCRL(req)
+	test.AssertNotError(t, err, "Failed to sign empty CRL")
+
+	req.Issuer = "unknown"
+	_, err = ca.GenerateCRL(req)
+	test.AssertError(t, err, "Signed CRL for an unknown issuer")
+
+	req.Issuer = "ecdsa"
+	req.NextUpdate = req.ThisUpdate
+	_, err = ca.GenerateCRL(req)
+	test.AssertError(t, err, "Signed CRL that expires when it's issued")
+
+	req.NextUpdate = thisUpdate.Add(24 * time.Hour)
+	req.RevokedCerts = []core.RevokedCertificate{core.RevokedCertificate{Serial: "not a serial"}}
+	_, err = ca.GenerateCRL(req)
+	test.AssertError(t, err, "Signed CRL with a malformed serial")
+}
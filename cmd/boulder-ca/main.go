@@ -11,6 +11,7 @@ import (
 
 	"github.com/letsencrypt/boulder/ca"
 	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/lint"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/rpc"
 )
@@ -41,6 +42,9 @@ func main() {
 		cai, err := ca.NewCertificateAuthorityImpl(cadb, c.CA, c.Common.IssuerCert)
 		cmd.FailOnError(err, "Failed to create CA impl")
 		cai.MaxKeySize = c.Common.MaxKeySize
+		pa := cmd.NewPolicyAuthority(c)
+		cai.PA = pa
+		cai.Lints = lint.NewLints(pa.IsPublicName)
 
 		go cmd.ProfileCmd("CA", stats)
 
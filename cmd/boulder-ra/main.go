@@ -6,14 +6,16 @@
 package main
 
 import (
+	"time"
+
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/streadway/amqp"
 
 	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/ra"
 	"github.com/letsencrypt/boulder/rpc"
-	"github.com/letsencrypt/boulder/wfe"
 )
 
 func main() {
@@ -32,8 +34,17 @@ func main() {
 		blog.SetAuditLogger(auditlogger)
 
 		rai := ra.NewRegistrationAuthorityImpl()
-		rai.AuthzBase = c.Common.BaseURL + wfe.AuthzPath
+		rai.PA = cmd.NewPolicyAuthority(c)
+		rai.AuthzBase = c.Common.BaseURL + core.AuthzPath
 		rai.MaxKeySize = c.Common.MaxKeySize
+		if c.RA.CAARecheckWindow != "" {
+			rai.CAARecheckWindow, err = time.ParseDuration(c.RA.CAARecheckWindow)
+			cmd.FailOnError(err, "Couldn't parse CAA recheck window")
+		}
+		rai.AuthorizationLifetime, rai.AuthorizationLifetimes = cmd.AuthorizationLifetimes(c)
+		rai.DefaultProfile = c.RA.DefaultProfile
+		rai.ProfileAllowLists = c.RA.ProfileAllowLists
+		rai.MustStapleAllowList = c.RA.MustStapleAllowList
 
 		go cmd.ProfileCmd("RA", stats)
 
@@ -6,6 +6,8 @@
 package main
 
 import (
+	"time"
+
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/streadway/amqp"
 
@@ -33,6 +35,10 @@ func main() {
 		sai, err := sa.NewSQLStorageAuthority(c.SA.DBDriver, c.SA.DBName)
 		cmd.FailOnError(err, "Failed to create SA impl")
 		sai.SetSQLDebug(c.SQL.SQLDebug)
+		if c.SA.PendingAuthorizationLifetime != "" {
+			sai.PendingAuthorizationLifetime, err = time.ParseDuration(c.SA.PendingAuthorizationLifetime)
+			cmd.FailOnError(err, "Couldn't parse pending authorization lifetime")
+		}
 
 		if c.SQL.CreateTables {
 			err = sai.CreateTablesIfNotExists()
@@ -6,8 +6,6 @@
 package main
 
 import (
-	"time"
-
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/streadway/amqp"
 
@@ -36,9 +34,15 @@ func main() {
 		go cmd.ProfileCmd("VA", stats)
 
 		vai := va.NewValidationAuthorityImpl(c.CA.TestMode)
-		dnsTimeout, err := time.ParseDuration(c.VA.DNSTimeout)
-		cmd.FailOnError(err, "Couldn't parse DNS timeout")
-		vai.DNSResolver = core.NewDNSResolver(dnsTimeout, []string{c.VA.DNSResolver})
+		vai.DNSResolver = cmd.NewDNSResolver(c, stats)
+		vai.CheckReverseZoneCAA = c.VA.CheckReverseZoneCAA
+		vai.IssuerDomains = c.VA.IssuerDomains
+		vai.RegBase = c.Common.BaseURL + core.RegPath
+		if suffixDB := cmd.NewPublicSuffixDB(c); suffixDB != nil {
+			vai.RegisteredDomain = suffixDB.RegisteredDomain
+		}
+		vai.ReservedRanges = cmd.ReservedAddressRanges(c)
+		vai.IodefReporter = cmd.NewIodefReporter(c, vai)
 
 		for {
 			ch := cmd.AmqpChannel(c.AMQP.Server)
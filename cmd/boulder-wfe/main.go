@@ -94,6 +94,7 @@ func main() {
 
 		wfe.IssuerCert, err = cmd.LoadCert(c.Common.IssuerCert)
 		cmd.FailOnError(err, fmt.Sprintf("Couldn't read issuer cert [%s]", c.Common.IssuerCert))
+		wfe.Issuers = cmd.IssuerCerts(c)
 
 		go cmd.ProfileCmd("WFE", stats)
 
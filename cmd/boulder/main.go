@@ -16,6 +16,7 @@ import (
 	"github.com/letsencrypt/boulder/ca"
 	"github.com/letsencrypt/boulder/cmd"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/lint"
 	blog "github.com/letsencrypt/boulder/log"
 	"github.com/letsencrypt/boulder/ra"
 	"github.com/letsencrypt/boulder/sa"
@@ -78,13 +79,23 @@ func main() {
 		sa, err := sa.NewSQLStorageAuthority(c.SA.DBDriver, c.SA.DBName)
 		cmd.FailOnError(err, "Unable to create SA")
 		sa.SetSQLDebug(c.SQL.SQLDebug)
+		if c.SA.PendingAuthorizationLifetime != "" {
+			sa.PendingAuthorizationLifetime, err = time.ParseDuration(c.SA.PendingAuthorizationLifetime)
+			cmd.FailOnError(err, "Couldn't parse pending authorization lifetime")
+		}
 
 		ra := ra.NewRegistrationAuthorityImpl()
 
+		pa := cmd.NewPolicyAuthority(c)
+
 		va := va.NewValidationAuthorityImpl(c.CA.TestMode)
-		dnsTimeout, err := time.ParseDuration(c.VA.DNSTimeout)
-		cmd.FailOnError(err, "Couldn't parse DNS timeout")
-		va.DNSResolver = core.NewDNSResolver(dnsTimeout, []string{c.VA.DNSResolver})
+		va.DNSResolver = cmd.NewDNSResolver(c, stats)
+		va.CheckReverseZoneCAA = c.VA.CheckReverseZoneCAA
+		va.IssuerDomains = c.VA.IssuerDomains
+		va.RegBase = c.Common.BaseURL + core.RegPath
+		va.RegisteredDomain = pa.RegisteredDomain
+		va.ReservedRanges = cmd.ReservedAddressRanges(c)
+		va.IodefReporter = cmd.NewIodefReporter(c, va)
 
 		cadb, err := ca.NewCertificateAuthorityDatabaseImpl(c.CA.DBDriver, c.CA.DBName)
 		cmd.FailOnError(err, "Failed to create CA database")
@@ -108,6 +119,7 @@ func main() {
 
 		wfei.IssuerCert, err = cmd.LoadCert(c.Common.IssuerCert)
 		cmd.FailOnError(err, fmt.Sprintf("Couldn't read issuer cert [%s]", c.Common.IssuerCert))
+		wfei.Issuers = cmd.IssuerCerts(c)
 
 		ra.CA = ca
 		ra.SA = sa
@@ -115,12 +127,24 @@ func main() {
 		va.RA = &ra
 		ca.SA = sa
 
+		ra.PA = pa
+		ca.PA = pa
+		ca.Lints = lint.NewLints(pa.IsPublicName)
+
 		// Set up paths
-		ra.AuthzBase = c.Common.BaseURL + wfe.AuthzPath
+		ra.AuthzBase = c.Common.BaseURL + core.AuthzPath
 		wfei.BaseURL = c.Common.BaseURL
 		wfei.HandlePaths()
 
 		ra.MaxKeySize = c.Common.MaxKeySize
+		if c.RA.CAARecheckWindow != "" {
+			ra.CAARecheckWindow, err = time.ParseDuration(c.RA.CAARecheckWindow)
+			cmd.FailOnError(err, "Couldn't parse CAA recheck window")
+		}
+		ra.AuthorizationLifetime, ra.AuthorizationLifetimes = cmd.AuthorizationLifetimes(c)
+		ra.DefaultProfile = c.RA.DefaultProfile
+		ra.ProfileAllowLists = c.RA.ProfileAllowLists
+		ra.MustStapleAllowList = c.RA.MustStapleAllowList
 		ca.MaxKeySize = c.Common.MaxKeySize
 
 		auditlogger.Info(app.VersionString())
@@ -0,0 +1,245 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/streadway/amqp"
+	gorp "github.com/letsencrypt/boulder/Godeps/_workspace/src/gopkg.in/gorp.v1"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/rpc"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+const (
+	defaultUpdateInterval = 24 * time.Hour
+	defaultValidity       = 7 * 24 * time.Hour
+)
+
+func setupClients(c cmd.Config) (rpc.CertificateAuthorityClient, chan *amqp.Error) {
+	ch := cmd.AmqpChannel(c.AMQP.Server)
+	closeChan := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+	caRPC, err := rpc.NewAmqpRPCClient("CRL->CA", c.AMQP.CA.Server, ch)
+	cmd.FailOnError(err, "Unable to create RPC client")
+
+	cac, err := rpc.NewCertificateAuthorityClient(caRPC)
+	cmd.FailOnError(err, "Unable to create CA client")
+
+	return cac, closeChan
+}
+
+// revokedCert is a revoked certificate, as selected from certificateStatus.
+type revokedCert struct {
+	Serial        string    `db:"serial"`
+	RevokedDate   time.Time `db:"revokedDate"`
+	RevokedReason int       `db:"revokedReason"`
+	DER           []byte    `db:"der"`
+}
+
+// generator signs a CRL for each issuer, listing the revoked certificates
+// that haven't expired, and stores them in the crls table.
+type generator struct {
+	dbMap   *gorp.DbMap
+	cac     core.CertificateAuthority
+	issuers map[string]*x509.Certificate
+	stats   statsd.Statter
+	log     *blog.AuditLogger
+
+	validity time.Duration
+}
+
+// findRevoked groups the revoked, unexpired certificates by the name of their
+// issuer.
+func (g *generator) findRevoked(now time.Time) (map[string][]core.RevokedCertificate, error) {
+	var certs []revokedCert
+	_, err := g.dbMap.Select(&certs,
+		`SELECT cs.serial, cs.revokedDate, cs.revokedReason, cert.der
+		 FROM certificateStatus AS cs JOIN certificates AS cert ON cs.serial = cert.serial
+		 WHERE cs.status = ? AND cert.expires > ?`, string(core.OCSPStatusRevoked), now)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make(map[string][]core.RevokedCertificate)
+	for _, c := range certs {
+		cert, err := x509.ParseCertificate(c.DER)
+		if err != nil {
+			return nil, fmt.Errorf("Couldn't parse certificate %s: %s", c.Serial, err)
+		}
+		issuer, err := g.issuerFor(cert)
+		if err != nil {
+			return nil, err
+		}
+		revoked[issuer] = append(revoked[issuer], core.RevokedCertificate{
+			Serial:    c.Serial,
+			RevokedAt: c.RevokedDate,
+			Reason:    c.RevokedReason,
+		})
+	}
+	return revoked, nil
+}
+
+// issuerFor returns the name of the issuer that issued a certificate.
+func (g *generator) issuerFor(cert *x509.Certificate) (string, error) {
+	for name, issuer := range g.issuers {
+		if !bytes.Equal(cert.RawIssuer, issuer.RawSubject) {
+			continue
+		}
+		if len(cert.AuthorityKeyId) > 0 && len(issuer.SubjectKeyId) > 0 &&
+			!bytes.Equal(cert.AuthorityKeyId, issuer.SubjectKeyId) {
+			continue
+		}
+		return name, nil
+	}
+	return "", fmt.Errorf("No issuer found for certificate %s issued by %s",
+		core.SerialToString(cert.SerialNumber), cert.Issuer.CommonName)
+}
+
+// generate signs and stores a new CRL for every issuer, even those with no
+// revoked certificates.
+func (g *generator) generate() error {
+	thisUpdate := time.Now()
+	nextUpdate := thisUpdate.Add(g.validity)
+
+	revoked, err := g.findRevoked(thisUpdate)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range g.issuers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err = g.sign(name, revoked[name], thisUpdate, nextUpdate); err != nil {
+			return fmt.Errorf("Couldn't generate CRL for issuer [%s]: %s", name, err)
+		}
+	}
+	return nil
+}
+
+// sign has the CA sign a CRL with the issuer's next CRL number, and stores
+// it.
+func (g *generator) sign(issuer string, revoked []core.RevokedCertificate, thisUpdate, nextUpdate time.Time) error {
+	last, err := g.dbMap.SelectInt("SELECT coalesce(max(number), 0) FROM crls WHERE issuer = ?", issuer)
+	if err != nil {
+		return err
+	}
+
+	crlDER, err := g.cac.GenerateCRL(core.CRLSigningRequest{
+		Issuer:       issuer,
+		Number:       last + 1,
+		ThisUpdate:   thisUpdate,
+		NextUpdate:   nextUpdate,
+		RevokedCerts: revoked,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = g.dbMap.Insert(&core.CRL{
+		Issuer:     issuer,
+		Number:     last + 1,
+		ThisUpdate: thisUpdate,
+		NextUpdate: nextUpdate,
+		CreatedAt:  time.Now(),
+		CRL:        crlDER,
+	})
+	if err != nil {
+		return err
+	}
+
+	g.stats.Inc("CRLGenerator.Signed", 1, 1.0)
+	g.log.Info(fmt.Sprintf("Stored CRL: issuer=[%s] number=[%d] entries=[%d]",
+		issuer, last+1, len(revoked)))
+	return nil
+}
+
+func parseDuration(value string, defaultValue time.Duration, name string) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	cmd.FailOnError(err, fmt.Sprintf("Couldn't parse %s", name))
+	return duration
+}
+
+func main() {
+	app := cmd.NewAppShell("crl-generator")
+
+	app.Action = func(c cmd.Config) {
+		// Set up logging
+		stats, err := statsd.NewClient(c.Statsd.Server, c.Statsd.Prefix)
+		cmd.FailOnError(err, "Couldn't connect to statsd")
+
+		auditlogger, err := blog.Dial(c.Syslog.Network, c.Syslog.Server, c.Syslog.Tag, stats)
+		cmd.FailOnError(err, "Could not connect to Syslog")
+
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		defer auditlogger.AuditPanic()
+
+		blog.SetAuditLogger(auditlogger)
+
+		auditlogger.Info(app.VersionString())
+
+		// Configure DB
+		dbMap, err := sa.NewDbMap(c.CRLGenerator.DBDriver, c.CRLGenerator.DBName)
+		cmd.FailOnError(err, "Could not connect to database")
+
+		cac, closeChan := setupClients(c)
+
+		go func() {
+			// Abort if we disconnect from AMQP
+			for {
+				for err := range closeChan {
+					auditlogger.Warning(fmt.Sprintf("AMQP Channel closed, aborting early: [%s]", err))
+					panic(err)
+				}
+			}
+		}()
+
+		g := &generator{
+			dbMap:    dbMap,
+			cac:      cac,
+			issuers:  make(map[string]*x509.Certificate),
+			stats:    stats,
+			log:      auditlogger,
+			validity: parseDuration(c.CRLGenerator.Validity, defaultValidity, "validity"),
+		}
+		for name, der := range cmd.IssuerCerts(c) {
+			issuer, err := x509.ParseCertificate(der)
+			cmd.FailOnError(err, fmt.Sprintf("Couldn't parse issuer cert [%s]", name))
+			g.issuers[name] = issuer
+		}
+		updateInterval := parseDuration(c.CRLGenerator.UpdateInterval, defaultUpdateInterval, "update interval")
+		if updateInterval >= g.validity {
+			cmd.FailOnError(errors.New("updateInterval must be shorter than validity"), "CRLs would expire before they're replaced")
+		}
+
+		for {
+			if err = g.generate(); err != nil {
+				// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+				auditlogger.Audit(fmt.Sprintf("CRL generation failed: %s", err))
+			}
+			time.Sleep(updateInterval)
+		}
+	}
+
+	app.Run()
+}
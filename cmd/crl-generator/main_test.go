@@ -0,0 +1,201 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	_ "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/mattn/go-sqlite3"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// mockCA records the CRLs it is asked to sign.
+type mockCA struct {
+	core.CertificateAuthority
+	requests []core.CRLSigningRequest
+}
+
+func (ca *mockCA) GenerateCRL(req core.CRLSigningRequest) ([]byte, error) {
+	ca.requests = append(ca.requests, req)
+	return []byte{byte(req.Number)}, nil
+}
+
+type testIssuer struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// newIssuer creates a self-signed issuer certificate with the given subject
+// and key identifier.
+func newIssuer(t *testing.T, name string, keyID byte) testIssuer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate issuer key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		SubjectKeyId:          []byte{keyID},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	test.AssertNotError(t, err, "Failed to create issuer")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "Failed to parse issuer")
+	return testIssuer{cert: cert, key: key}
+}
+
+// setup returns a generator with a database in a temporary directory, which
+// the returned function removes.
+func setup(t *testing.T, issuers map[string]testIssuer) (*generator, *mockCA, func()) {
+	dir, err := ioutil.TempDir("", "crl-generator")
+	test.AssertNotError(t, err, "Couldn't create temporary directory")
+	dbMap, err := sa.NewDbMap("sqlite3", filepath.Join(dir, "boulder.db"))
+	test.AssertNotError(t, err, "Couldn't connect to database")
+	err = dbMap.CreateTablesIfNotExists()
+	test.AssertNotError(t, err, "Couldn't create tables")
+
+	stats, _ := statsd.NewNoopClient(nil)
+	ca := &mockCA{}
+	g := &generator{
+		dbMap:    dbMap,
+		cac:      ca,
+		issuers:  make(map[string]*x509.Certificate),
+		stats:    stats,
+		log:      blog.GetAuditLogger(),
+		validity: time.Hour,
+	}
+	for name, issuer := range issuers {
+		g.issuers[name] = issuer.cert
+	}
+	return g, ca, func() { os.RemoveAll(dir) }
+}
+
+// addCertificate stores a certificate from the issuer that expires at the
+// given time, with the given OCSP status, and returns its serial.
+func addCertificate(t *testing.T, g *generator, issuer testIssuer, serial int64, expires time.Time, status core.OCSPStatus) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     expires,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer.cert, &key.PublicKey, issuer.key)
+	test.AssertNotError(t, err, "Failed to create certificate")
+
+	cert := &core.Certificate{
+		Serial:  core.SerialToString(template.SerialNumber),
+		Status:  core.StatusValid,
+		DER:     der,
+		Issued:  time.Now(),
+		Expires: expires,
+	}
+	err = g.dbMap.Insert(cert)
+	test.AssertNotError(t, err, "Failed to store certificate")
+	err = g.dbMap.Insert(&core.CertificateStatus{
+		Serial:        cert.Serial,
+		Status:        status,
+		RevokedDate:   time.Now().Add(-time.Minute),
+		RevokedReason: 1,
+	})
+	test.AssertNotError(t, err, "Failed to store certificate status")
+	return cert.Serial
+}
+
+func serials(revoked []core.RevokedCertificate) []string {
+	var serials []string
+	for _, cert := range revoked {
+		serials = append(serials, cert.Serial)
+	}
+	return serials
+}
+
+func TestFindRevoked(t *testing.T) {
+	one := newIssuer(t, "Issuer one", 1)
+	two := newIssuer(t, "Issuer two", 2)
+	g, _, cleanup := setup(t, map[string]testIssuer{"one": one, "two": two})
+	defer cleanup()
+
+	later := time.Now().Add(time.Hour)
+	oneFirst := addCertificate(t, g, one, 2, later, core.OCSPStatusRevoked)
+	oneSecond := addCertificate(t, g, one, 3, later, core.OCSPStatusRevoked)
+	twoFirst := addCertificate(t, g, two, 4, later, core.OCSPStatusRevoked)
+	// Neither certificates that aren't revoked nor expired ones are listed
+	addCertificate(t, g, one, 6, later, core.OCSPStatusGood)
+	addCertificate(t, g, two, 8, time.Now().Add(-time.Minute), core.OCSPStatusRevoked)
+
+	revoked, err := g.findRevoked(time.Now())
+	test.AssertNotError(t, err, "Couldn't find revoked certificates")
+	test.AssertEquals(t, len(revoked), 2)
+	oneSerials := serials(revoked["one"])
+	sort.Strings(oneSerials)
+	test.AssertDeepEquals(t, oneSerials, []string{oneFirst, oneSecond})
+	test.AssertDeepEquals(t, serials(revoked["two"]), []string{twoFirst})
+	entry := revoked["one"][0]
+	test.AssertEquals(t, entry.Reason, 1)
+	test.Assert(t, !entry.RevokedAt.IsZero(), "No revocation time")
+
+	// A certificate from an issuer with the same subject but another key
+	// isn't attributed to the configured issuer
+	impostor := newIssuer(t, "Issuer one", 3)
+	addCertificate(t, g, impostor, 10, later, core.OCSPStatusRevoked)
+	_, err = g.findRevoked(time.Now())
+	test.AssertError(t, err, "Certificate attributed to the wrong issuer")
+}
+
+func TestGenerate(t *testing.T) {
+	one := newIssuer(t, "Issuer one", 1)
+	two := newIssuer(t, "Issuer two", 2)
+	g, ca, cleanup := setup(t, map[string]testIssuer{"one": one, "two": two})
+	defer cleanup()
+	serial := addCertificate(t, g, one, 2, time.Now().Add(time.Hour), core.OCSPStatusRevoked)
+
+	// Every issuer gets a CRL, even without revoked certificates
+	err := g.generate()
+	test.AssertNotError(t, err, "Couldn't generate CRLs")
+	test.AssertEquals(t, len(ca.requests), 2)
+	test.AssertEquals(t, ca.requests[0].Issuer, "one")
+	test.AssertDeepEquals(t, serials(ca.requests[0].RevokedCerts), []string{serial})
+	test.AssertEquals(t, ca.requests[1].Issuer, "two")
+	test.AssertEquals(t, len(ca.requests[1].RevokedCerts), 0)
+	test.AssertEquals(t, ca.requests[0].NextUpdate.Sub(ca.requests[0].ThisUpdate), g.validity)
+
+	// CRL numbers increase with each CRL for an issuer
+	err = g.generate()
+	test.AssertNotError(t, err, "Couldn't generate CRLs")
+	for _, name := range []string{"one", "two"} {
+		var crls []core.CRL
+		_, err = g.dbMap.Select(&crls, "SELECT * FROM crls WHERE issuer = ? ORDER BY number", name)
+		test.AssertNotError(t, err, "Couldn't select CRLs")
+		test.AssertEquals(t, len(crls), 2)
+		for i, crl := range crls {
+			test.AssertEquals(t, crl.Number, int64(i+1))
+			test.AssertByteEquals(t, crl.CRL, []byte{byte(i + 1)})
+		}
+	}
+	test.AssertEquals(t, ca.requests[2].Number, int64(2))
+	test.AssertEquals(t, ca.requests[3].Number, int64(2))
+}
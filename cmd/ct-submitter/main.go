@@ -0,0 +1,293 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	gorp "github.com/letsencrypt/boulder/Godeps/_workspace/src/gopkg.in/gorp.v1"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ct"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+const (
+	defaultPollInterval    = time.Minute
+	defaultBatchSize       = 100
+	defaultRetryBackoff    = time.Minute
+	defaultMaxRetryBackoff = 6 * time.Hour
+	defaultMaxAttempts     = 20
+
+	// The length of the ctSubmissions error column; longer errors, such as
+	// those quoting a log's whole response, are truncated to fit
+	maxErrorLength = 1024
+)
+
+// submitter submits every unexpired certificate to each CT log, and records
+// the outcome in the ctSubmissions table.
+type submitter struct {
+	dbMap   *gorp.DbMap
+	logs    map[string]*ct.Log // by base64 log ID
+	issuers []*x509.Certificate
+	stats   statsd.Statter
+	log     *blog.AuditLogger
+
+	batchSize       int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	maxAttempts     int
+}
+
+// queueNew adds a pending submission to each log for certificates that don't
+// have one yet, up to batchSize per log.
+func (s *submitter) queueNew() error {
+	now := time.Now()
+	for logID := range s.logs {
+		var serials []string
+		_, err := s.dbMap.Select(&serials,
+			`SELECT cert.serial FROM certificates AS cert
+			 WHERE cert.expires > ? AND NOT EXISTS
+			 (SELECT 1 FROM ctSubmissions AS sub WHERE sub.serial = cert.serial AND sub.logID = ?)
+			 ORDER BY cert.issued ASC
+			 LIMIT ?`, now, logID, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, serial := range serials {
+			err = s.dbMap.Insert(&core.CTSubmission{
+				Serial:      serial,
+				LogID:       logID,
+				Status:      core.StatusPending,
+				NextAttempt: now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// submitDue attempts up to batchSize pending submissions that are due.
+func (s *submitter) submitDue() error {
+	var logIDs []interface{}
+	for logID := range s.logs {
+		logIDs = append(logIDs, logID)
+	}
+	args := append([]interface{}{string(core.StatusPending), time.Now()}, logIDs...)
+	args = append(args, s.batchSize)
+
+	// Only logs that are still configured; submissions to others stay
+	// pending in case they return.
+	var submissions []core.CTSubmission
+	_, err := s.dbMap.Select(&submissions,
+		`SELECT * FROM ctSubmissions
+		 WHERE status = ? AND nextAttempt <= ? AND logID IN (?`+strings.Repeat(", ?", len(logIDs)-1)+`)
+		 ORDER BY nextAttempt ASC
+		 LIMIT ?`, args...)
+	if err != nil {
+		return err
+	}
+
+	// A submission that can't be recorded is retried on the next poll, but
+	// doesn't hold up the rest of the batch
+	var failed int
+	for i := range submissions {
+		if err = s.submit(&submissions[i]); err != nil {
+			failed++
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			s.log.Audit(fmt.Sprintf("Couldn't record CT submission: serial=[%s] logID=[%s] err=[%s]",
+				submissions[i].Serial, submissions[i].LogID, err))
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("Couldn't record %d of %d CT submissions", failed, len(submissions))
+	}
+	return nil
+}
+
+// submit makes one attempt at a submission and records the result. Only
+// failing to record it is returned as an error.
+func (s *submitter) submit(submission *core.CTSubmission) error {
+	log := s.logs[submission.LogID]
+	submission.Attempts++
+
+	sct, permanent, err := s.attempt(log, submission.Serial)
+	if err == nil {
+		submission.Status = core.StatusValid
+		submission.Error = ""
+		submission.Timestamp = sct.Timestamp
+		submission.Extensions = sct.Extensions
+		submission.Signature = sct.Signature
+		s.stats.Inc("CTSubmitter.Logged", 1, 1.0)
+		s.log.Info(fmt.Sprintf("Certificate logged: serial=[%s] log=[%s]", submission.Serial, log.URI))
+	} else {
+		submission.Error = truncate(err.Error(), maxErrorLength)
+		if permanent || submission.Attempts >= s.maxAttempts {
+			submission.Status = core.StatusInvalid
+			s.stats.Inc("CTSubmitter.Failed", 1, 1.0)
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			s.log.Audit(fmt.Sprintf("Giving up on CT submission: serial=[%s] log=[%s] attempts=[%d] err=[%s]",
+				submission.Serial, log.URI, submission.Attempts, err))
+		} else {
+			submission.NextAttempt = time.Now().Add(s.retryDelay(submission.Attempts))
+			s.stats.Inc("CTSubmitter.Retried", 1, 1.0)
+			s.log.Warning(fmt.Sprintf("CT submission failed, retrying at %s: serial=[%s] log=[%s] err=[%s]",
+				submission.NextAttempt, submission.Serial, log.URI, err))
+		}
+	}
+
+	_, err = s.dbMap.Update(submission)
+	return err
+}
+
+// attempt submits a certificate to a log. Errors that retrying can't fix are
+// reported as permanent.
+func (s *submitter) attempt(log *ct.Log, serial string) (sct core.SignedCertificateTimestamp, permanent bool, err error) {
+	certObj, err := s.dbMap.Get(core.Certificate{}, serial)
+	if err != nil {
+		return
+	}
+	if certObj == nil {
+		return sct, true, fmt.Errorf("Certificate %s does not exist", serial)
+	}
+	cert, err := x509.ParseCertificate(certObj.(*core.Certificate).DER)
+	if err != nil {
+		return sct, true, err
+	}
+
+	for _, issuer := range s.issuers {
+		if cert.CheckSignatureFrom(issuer) == nil {
+			sct, err = log.SubmitCertificate(cert, issuer)
+			return
+		}
+	}
+	return sct, true, errors.New("No issuer certificate found")
+}
+
+// truncate shortens a string to at most max characters.
+func truncate(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
+
+// retryDelay is how long to wait before the next attempt, after a number of
+// failed ones.
+func (s *submitter) retryDelay(attempts int) time.Duration {
+	delay := s.retryBackoff
+	for i := 1; i < attempts && delay < s.maxRetryBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.maxRetryBackoff {
+		delay = s.maxRetryBackoff
+	}
+	return delay
+}
+
+// reportBacklog sends the number of pending submissions to statsd.
+func (s *submitter) reportBacklog() error {
+	backlog, err := s.dbMap.SelectInt("SELECT count(*) FROM ctSubmissions WHERE status = ?", string(core.StatusPending))
+	if err != nil {
+		return err
+	}
+	s.stats.Gauge("CTSubmitter.Backlog", backlog, 1.0)
+	return nil
+}
+
+func parseDuration(value string, defaultValue time.Duration, name string) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	cmd.FailOnError(err, fmt.Sprintf("Couldn't parse %s", name))
+	return duration
+}
+
+func main() {
+	app := cmd.NewAppShell("ct-submitter")
+
+	app.Action = func(c cmd.Config) {
+		// Set up logging
+		stats, err := statsd.NewClient(c.Statsd.Server, c.Statsd.Prefix)
+		cmd.FailOnError(err, "Couldn't connect to statsd")
+
+		auditlogger, err := blog.Dial(c.Syslog.Network, c.Syslog.Server, c.Syslog.Tag, stats)
+		cmd.FailOnError(err, "Could not connect to Syslog")
+
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		defer auditlogger.AuditPanic()
+
+		blog.SetAuditLogger(auditlogger)
+
+		auditlogger.Info(app.VersionString())
+
+		// Configure DB
+		dbMap, err := sa.NewDbMap(c.CTSubmitter.DBDriver, c.CTSubmitter.DBName)
+		cmd.FailOnError(err, "Could not connect to database")
+
+		config := c.CTSubmitter.CT
+		config.SCTsRequired = 0
+		ctSubmitter, err := ct.NewSubmitter(config)
+		cmd.FailOnError(err, "Couldn't configure CT logs")
+		if ctSubmitter == nil {
+			cmd.FailOnError(errors.New("no logs configured"), "Nothing to submit to")
+		}
+
+		s := &submitter{
+			dbMap:           dbMap,
+			logs:            make(map[string]*ct.Log),
+			stats:           stats,
+			log:             auditlogger,
+			batchSize:       c.CTSubmitter.BatchSize,
+			retryBackoff:    parseDuration(c.CTSubmitter.RetryBackoff, defaultRetryBackoff, "retry backoff"),
+			maxRetryBackoff: parseDuration(c.CTSubmitter.MaxRetryBackoff, defaultMaxRetryBackoff, "maximum retry backoff"),
+			maxAttempts:     c.CTSubmitter.MaxAttempts,
+		}
+		for _, log := range ctSubmitter.Logs {
+			s.logs[base64.StdEncoding.EncodeToString(log.ID)] = log
+		}
+		for name, der := range cmd.IssuerCerts(c) {
+			issuer, err := x509.ParseCertificate(der)
+			cmd.FailOnError(err, fmt.Sprintf("Couldn't parse issuer cert [%s]", name))
+			s.issuers = append(s.issuers, issuer)
+		}
+		if s.batchSize <= 0 {
+			s.batchSize = defaultBatchSize
+		}
+		if s.maxAttempts <= 0 {
+			s.maxAttempts = defaultMaxAttempts
+		}
+		pollInterval := parseDuration(c.CTSubmitter.PollInterval, defaultPollInterval, "poll interval")
+
+		for {
+			if err = s.queueNew(); err != nil {
+				auditlogger.Err(fmt.Sprintf("Couldn't queue new certificates: %s", err))
+			}
+			if err = s.submitDue(); err != nil {
+				auditlogger.Err(fmt.Sprintf("Couldn't process CT submissions: %s", err))
+			}
+			if err = s.reportBacklog(); err != nil {
+				auditlogger.Err(fmt.Sprintf("Couldn't count CT submission backlog: %s", err))
+			}
+			time.Sleep(pollInterval)
+		}
+	}
+
+	app.Run()
+}
@@ -0,0 +1,302 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	_ "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/mattn/go-sqlite3"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ct"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func TestRetryDelay(t *testing.T) {
+	s := &submitter{retryBackoff: time.Minute, maxRetryBackoff: 6 * time.Hour}
+	tests := []struct {
+		attempts int
+		delay    time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{9, 256 * time.Minute},
+		{10, 6 * time.Hour},
+		{1000, 6 * time.Hour},
+	}
+	for _, tc := range tests {
+		test.AssertEquals(t, s.retryDelay(tc.attempts), tc.delay)
+	}
+
+	// A backoff above the maximum is capped from the first retry
+	s.retryBackoff = 12 * time.Hour
+	test.AssertEquals(t, s.retryDelay(1), 6*time.Hour)
+}
+
+// newIssuer creates a self-signed issuer certificate and its key.
+func newIssuer(t *testing.T, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate issuer key")
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	test.AssertNotError(t, err, "Failed to create issuer")
+	issuer, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "Failed to parse issuer")
+	return issuer, key
+}
+
+// addCertificate stores a certificate from the issuer that expires at the
+// given time, and returns its serial.
+func addCertificate(t *testing.T, s *submitter, issuer *x509.Certificate, issuerKey *ecdsa.PrivateKey, serial int64, expires time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     expires,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	test.AssertNotError(t, err, "Failed to create certificate")
+
+	cert := &core.Certificate{
+		Serial:  core.SerialToString(template.SerialNumber),
+		Status:  core.StatusValid,
+		DER:     der,
+		Issued:  time.Now().Add(time.Duration(serial) * time.Second),
+		Expires: expires,
+	}
+	err = s.dbMap.Insert(cert)
+	test.AssertNotError(t, err, "Failed to store certificate")
+	return cert.Serial
+}
+
+// setup returns a submitter with a database in a temporary directory, which
+// the returned function removes, submitting to the given logs.
+func setup(t *testing.T, issuer *x509.Certificate, logs ...*test.MockCTLog) (*submitter, func()) {
+	dir, err := ioutil.TempDir("", "ct-submitter")
+	test.AssertNotError(t, err, "Couldn't create temporary directory")
+	dbMap, err := sa.NewDbMap("sqlite3", filepath.Join(dir, "boulder.db"))
+	test.AssertNotError(t, err, "Couldn't connect to database")
+	err = dbMap.CreateTablesIfNotExists()
+	test.AssertNotError(t, err, "Couldn't create tables")
+
+	stats, _ := statsd.NewNoopClient(nil)
+	s := &submitter{
+		dbMap:           dbMap,
+		logs:            make(map[string]*ct.Log),
+		issuers:         []*x509.Certificate{issuer},
+		stats:           stats,
+		log:             blog.GetAuditLogger(),
+		batchSize:       10,
+		retryBackoff:    time.Minute,
+		maxRetryBackoff: time.Hour,
+		maxAttempts:     2,
+	}
+	for _, mockLog := range logs {
+		log, err := ct.NewLog(ct.LogDescription{URI: mockLog.URL, Key: mockLog.Key}, time.Second)
+		test.AssertNotError(t, err, "Couldn't create log")
+		s.logs[base64.StdEncoding.EncodeToString(log.ID)] = log
+	}
+	return s, func() { os.RemoveAll(dir) }
+}
+
+// submission returns the stored submission of a certificate to a log.
+func submission(t *testing.T, s *submitter, serial string, log *test.MockCTLog) core.CTSubmission {
+	var logID string
+	for id, l := range s.logs {
+		if l.URI == log.URL {
+			logID = id
+		}
+	}
+	var sub core.CTSubmission
+	err := s.dbMap.SelectOne(&sub, "SELECT * FROM ctSubmissions WHERE serial = ? AND logID = ?", serial, logID)
+	test.AssertNotError(t, err, "Couldn't find submission")
+	return sub
+}
+
+func TestQueueNew(t *testing.T) {
+	issuer, issuerKey := newIssuer(t, "CT submitter test issuer")
+	log := test.NewMockCTLog()
+	defer log.Close()
+	s, cleanup := setup(t, issuer, log)
+	defer cleanup()
+	s.batchSize = 2
+
+	first := addCertificate(t, s, issuer, issuerKey, 1, time.Now().Add(time.Hour))
+	second := addCertificate(t, s, issuer, issuerKey, 2, time.Now().Add(time.Hour))
+	third := addCertificate(t, s, issuer, issuerKey, 3, time.Now().Add(time.Hour))
+	addCertificate(t, s, issuer, issuerKey, 4, time.Now().Add(-time.Minute))
+
+	// Certificates are queued oldest first, a batch at a time, and only once
+	err := s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+	test.AssertEquals(t, submission(t, s, first, log).Status, core.StatusPending)
+	test.AssertEquals(t, submission(t, s, second, log).Status, core.StatusPending)
+	count, err := s.dbMap.SelectInt("SELECT count(*) FROM ctSubmissions")
+	test.AssertNotError(t, err, "Couldn't count submissions")
+	test.AssertEquals(t, count, int64(2))
+
+	err = s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+	test.AssertEquals(t, submission(t, s, third, log).Status, core.StatusPending)
+
+	// Expired certificates aren't queued
+	err = s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+	count, err = s.dbMap.SelectInt("SELECT count(*) FROM ctSubmissions")
+	test.AssertNotError(t, err, "Couldn't count submissions")
+	test.AssertEquals(t, count, int64(3))
+}
+
+func TestSubmitDue(t *testing.T) {
+	issuer, issuerKey := newIssuer(t, "CT submitter test issuer")
+	other, otherKey := newIssuer(t, "Unconfigured issuer")
+	good := test.NewMockCTLog()
+	defer good.Close()
+	failing := test.NewMockCTLog()
+	defer failing.Close()
+	failing.SetFail(true)
+	s, cleanup := setup(t, issuer, good, failing)
+	defer cleanup()
+
+	serial := addCertificate(t, s, issuer, issuerKey, 1, time.Now().Add(time.Hour))
+	unknown := addCertificate(t, s, other, otherKey, 2, time.Now().Add(time.Hour))
+	err := s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+
+	before := time.Now()
+	err = s.submitDue()
+	test.AssertNotError(t, err, "Couldn't submit certificates")
+
+	// pending -> valid, with the log's SCT
+	sub := submission(t, s, serial, good)
+	test.AssertEquals(t, sub.Status, core.StatusValid)
+	test.AssertEquals(t, sub.Attempts, 1)
+	test.AssertEquals(t, sub.Error, "")
+	test.Assert(t, len(sub.Signature) > 0, "No SCT signature stored")
+
+	// A log error leaves the submission pending until the retry is due
+	sub = submission(t, s, serial, failing)
+	test.AssertEquals(t, sub.Status, core.StatusPending)
+	test.AssertEquals(t, sub.Attempts, 1)
+	test.Assert(t, sub.Error != "", "No error recorded")
+	test.Assert(t, !sub.NextAttempt.Before(before.Add(s.retryBackoff)), "Retry is due too soon")
+
+	// pending -> invalid at once if the certificate's issuer isn't known
+	sub = submission(t, s, unknown, good)
+	test.AssertEquals(t, sub.Status, core.StatusInvalid)
+	test.AssertEquals(t, sub.Attempts, 1)
+
+	// Submissions that aren't due yet aren't attempted
+	submissions := failing.Submissions()
+	err = s.submitDue()
+	test.AssertNotError(t, err, "Couldn't submit certificates")
+	test.AssertEquals(t, failing.Submissions(), submissions)
+
+	// pending -> invalid once maxAttempts have failed
+	_, err = s.dbMap.Exec("UPDATE ctSubmissions SET nextAttempt = ? WHERE status = ?",
+		time.Now().Add(-time.Second), string(core.StatusPending))
+	test.AssertNotError(t, err, "Couldn't make retry due")
+	err = s.submitDue()
+	test.AssertNotError(t, err, "Couldn't submit certificates")
+	sub = submission(t, s, serial, failing)
+	test.AssertEquals(t, sub.Status, core.StatusInvalid)
+	test.AssertEquals(t, sub.Attempts, 2)
+
+	// Finished submissions aren't attempted again
+	submissions = good.Submissions() + failing.Submissions()
+	_, err = s.dbMap.Exec("UPDATE ctSubmissions SET nextAttempt = ?", time.Now().Add(-time.Second))
+	test.AssertNotError(t, err, "Couldn't make submissions due")
+	err = s.submitDue()
+	test.AssertNotError(t, err, "Couldn't submit certificates")
+	test.AssertEquals(t, good.Submissions()+failing.Submissions(), submissions)
+}
+
+func TestSubmitDueLongError(t *testing.T) {
+	issuer, issuerKey := newIssuer(t, "CT submitter test issuer")
+	mockLog := test.NewMockCTLog()
+	defer mockLog.Close()
+	s, cleanup := setup(t, issuer, mockLog)
+	defer cleanup()
+
+	// A log that fails with a response longer than the error column
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, strings.Repeat("é", 2*maxErrorLength), http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	for id, log := range s.logs {
+		log.URI = server.URL
+		s.logs[id] = log
+	}
+
+	serial := addCertificate(t, s, issuer, issuerKey, 1, time.Now().Add(time.Hour))
+	err := s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+	err = s.submitDue()
+	test.AssertNotError(t, err, "Couldn't submit certificates")
+
+	var sub core.CTSubmission
+	err = s.dbMap.SelectOne(&sub, "SELECT * FROM ctSubmissions WHERE serial = ?", serial)
+	test.AssertNotError(t, err, "Couldn't find submission")
+	test.AssertEquals(t, sub.Status, core.StatusPending)
+	test.AssertEquals(t, utf8.RuneCountInString(sub.Error), maxErrorLength)
+	test.Assert(t, utf8.ValidString(sub.Error), "Truncated error isn't valid UTF-8")
+}
+
+func TestSubmitDueRecordFailure(t *testing.T) {
+	issuer, issuerKey := newIssuer(t, "CT submitter test issuer")
+	log := test.NewMockCTLog()
+	defer log.Close()
+	s, cleanup := setup(t, issuer, log)
+	defer cleanup()
+
+	stuck := addCertificate(t, s, issuer, issuerKey, 1, time.Now().Add(time.Hour))
+	other := addCertificate(t, s, issuer, issuerKey, 2, time.Now().Add(time.Hour))
+	err := s.queueNew()
+	test.AssertNotError(t, err, "Couldn't queue certificates")
+
+	// Recording one submission fails, as a too-long value would in strict
+	// MySQL
+	_, err = s.dbMap.Exec(`CREATE TRIGGER refuseUpdate BEFORE UPDATE ON ctSubmissions
+		WHEN NEW.serial = '` + stuck + `' BEGIN SELECT RAISE(ABORT, 'refused'); END`)
+	test.AssertNotError(t, err, "Couldn't create trigger")
+
+	// The rest of the batch is still recorded
+	err = s.submitDue()
+	test.AssertError(t, err, "Failure to record a submission wasn't reported")
+	test.AssertEquals(t, log.Submissions(), 2)
+	test.AssertEquals(t, submission(t, s, stuck, log).Status, core.StatusPending)
+	test.AssertEquals(t, submission(t, s, other, log).Status, core.StatusValid)
+}
@@ -0,0 +1,209 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/codegangsta/cli"
+	gorp "github.com/letsencrypt/boulder/Godeps/_workspace/src/gopkg.in/gorp.v1"
+
+	"github.com/letsencrypt/boulder/cmd"
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+)
+
+const defaultBatchSize = 1000
+
+// Tables holding authorizations, pending first so that the larger backlog of
+// abandoned authorizations is cleared before final ones
+var authzTables = []string{"pending_authz", "authz"}
+
+// archivedAuthz is the form in which purged rows are archived
+type archivedAuthz struct {
+	Table         string
+	Authorization core.Authorization
+	Purged        time.Time
+}
+
+type purger struct {
+	dbMap     *gorp.DbMap
+	log       *blog.AuditLogger
+	archive   *json.Encoder
+	batchSize int
+
+	// Lifetime given to pending authorizations that have no expiry
+	pendingLifetime time.Duration
+}
+
+// backfillPendingExpiry gives the pending authorizations created before
+// expiry times were set on them an expiry one pending lifetime from now, so
+// that they stop being usable and are purged like any other.  It returns the
+// number of rows updated.
+func (p *purger) backfillPendingExpiry(now time.Time) (int64, error) {
+	result, err := p.dbMap.Exec("UPDATE pending_authz SET expires = ? WHERE expires IS NULL",
+		now.Add(p.pendingLifetime))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// purgeBatch deletes up to batchSize rows of the table that expired before
+// the cutoff, archiving them first if an archive is configured.  It returns
+// the number of rows deleted.
+func (p *purger) purgeBatch(table string, cutoff time.Time) (int, error) {
+	var authzs []core.Authorization
+	_, err := p.dbMap.Select(&authzs,
+		`SELECT id, identifier, registrationID, status, expires, challenges, combinations, caaChecked
+		 FROM `+table+` WHERE expires < ?
+		 ORDER BY expires ASC
+		 LIMIT ?`, cutoff, p.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(authzs) == 0 {
+		return 0, nil
+	}
+
+	if p.archive != nil {
+		now := time.Now()
+		for _, authz := range authzs {
+			if err = p.archive.Encode(archivedAuthz{Table: table, Authorization: authz, Purged: now}); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	tx, err := p.dbMap.Begin()
+	if err != nil {
+		return 0, err
+	}
+	for _, authz := range authzs {
+		if _, err = tx.Exec("DELETE FROM "+table+" WHERE id = ?", authz.ID); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(authzs), nil
+}
+
+// purge deletes all rows of the table that expired before the cutoff, one
+// batch at a time.
+func (p *purger) purge(table string, cutoff time.Time) (int, error) {
+	total := 0
+	for {
+		count, err := p.purgeBatch(table, cutoff)
+		if err != nil {
+			return total, err
+		}
+		if count == 0 {
+			return total, nil
+		}
+		total += count
+		p.log.Info(fmt.Sprintf("Purged %d expired authorizations from %s (%d so far)", count, table, total))
+	}
+}
+
+func main() {
+	app := cmd.NewAppShell("expired-authz-purger")
+
+	app.App.Flags = append(app.App.Flags, cli.IntFlag{
+		Name:   "batch-size",
+		Value:  0,
+		EnvVar: "AUTHZ_PURGE_BATCH_SIZE",
+		Usage:  "Count of rows to delete per transaction",
+	}, cli.StringFlag{
+		Name:   "archive",
+		EnvVar: "AUTHZ_PURGE_ARCHIVE",
+		Usage:  "File to append purged rows to, as JSON",
+	})
+
+	app.Config = func(c *cli.Context, config cmd.Config) cmd.Config {
+		if c.GlobalInt("batch-size") > 0 {
+			config.AuthzPurger.BatchSize = c.GlobalInt("batch-size")
+		}
+		if c.GlobalString("archive") != "" {
+			config.AuthzPurger.ArchiveFile = c.GlobalString("archive")
+		}
+		return config
+	}
+
+	app.Action = func(c cmd.Config) {
+		// Set up logging
+		stats, err := statsd.NewClient(c.Statsd.Server, c.Statsd.Prefix)
+		cmd.FailOnError(err, "Couldn't connect to statsd")
+
+		auditlogger, err := blog.Dial(c.Syslog.Network, c.Syslog.Server, c.Syslog.Tag, stats)
+		cmd.FailOnError(err, "Could not connect to Syslog")
+
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		defer auditlogger.AuditPanic()
+
+		blog.SetAuditLogger(auditlogger)
+
+		auditlogger.Info(app.VersionString())
+
+		// Configure DB
+		dbMap, err := sa.NewDbMap(c.AuthzPurger.DBDriver, c.AuthzPurger.DBName)
+		cmd.FailOnError(err, "Could not connect to database")
+
+		p := &purger{
+			dbMap:           dbMap,
+			log:             auditlogger,
+			batchSize:       c.AuthzPurger.BatchSize,
+			pendingLifetime: sa.DefaultPendingAuthorizationLifetime,
+		}
+		if p.batchSize <= 0 {
+			p.batchSize = defaultBatchSize
+		}
+		if c.SA.PendingAuthorizationLifetime != "" {
+			p.pendingLifetime, err = time.ParseDuration(c.SA.PendingAuthorizationLifetime)
+			cmd.FailOnError(err, "Couldn't parse pending authorization lifetime")
+		}
+
+		if c.AuthzPurger.ArchiveFile != "" {
+			archive, err := os.OpenFile(c.AuthzPurger.ArchiveFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+			cmd.FailOnError(err, "Could not open archive file")
+			defer archive.Close()
+			p.archive = json.NewEncoder(archive)
+		}
+
+		// Calculate the cut-off timestamp
+		var grace time.Duration
+		if c.AuthzPurger.GracePeriod != "" {
+			grace, err = time.ParseDuration(c.AuthzPurger.GracePeriod)
+			cmd.FailOnError(err, "Could not parse GracePeriod from config.")
+		}
+		now := time.Now()
+		cutoff := now.Add(-grace)
+
+		backfilled, err := p.backfillPendingExpiry(now)
+		cmd.FailOnError(err, "Failed setting expiry times on pending authorizations")
+		if backfilled > 0 {
+			auditlogger.Audit(fmt.Sprintf("Set expiry of %d pending authorizations without one to %s",
+				backfilled, now.Add(p.pendingLifetime)))
+		}
+
+		auditlogger.Info(fmt.Sprintf("Purging authorizations that expired before %s", cutoff))
+
+		for _, table := range authzTables {
+			count, err := p.purge(table, cutoff)
+			cmd.FailOnError(err, fmt.Sprintf("Failed purging %s after %d rows", table, count))
+			auditlogger.Audit(fmt.Sprintf("Purged %d expired authorizations from %s", count, table))
+		}
+	}
+
+	app.Run()
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/mattn/go-sqlite3"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// setup returns a purger and an SA sharing a database in a temporary
+// directory, which the returned function removes.
+func setup(t *testing.T) (*purger, *sa.SQLStorageAuthority, func()) {
+	dir, err := ioutil.TempDir("", "authz-purger")
+	test.AssertNotError(t, err, "Couldn't create temporary directory")
+	dbName := filepath.Join(dir, "boulder.db")
+
+	ssa, err := sa.NewSQLStorageAuthority("sqlite3", dbName)
+	test.AssertNotError(t, err, "Couldn't create SA")
+	err = ssa.CreateTablesIfNotExists()
+	test.AssertNotError(t, err, "Couldn't create tables")
+
+	dbMap, err := sa.NewDbMap("sqlite3", dbName)
+	test.AssertNotError(t, err, "Couldn't connect to database")
+	p := &purger{
+		dbMap:           dbMap,
+		log:             blog.GetAuditLogger(),
+		batchSize:       1,
+		pendingLifetime: sa.DefaultPendingAuthorizationLifetime,
+	}
+	return p, ssa, func() { os.RemoveAll(dir) }
+}
+
+// newPending creates a pending authorization that expires after lifetime.
+func newPending(t *testing.T, ssa *sa.SQLStorageAuthority, lifetime time.Duration) core.Authorization {
+	ssa.PendingAuthorizationLifetime = lifetime
+	authz, err := ssa.NewPendingAuthorization(core.Authorization{
+		Identifier: core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "example.com"},
+	})
+	test.AssertNotError(t, err, "Couldn't create pending authorization")
+	return authz
+}
+
+func count(t *testing.T, p *purger, table string) int64 {
+	n, err := p.dbMap.SelectInt("SELECT count(*) FROM " + table)
+	test.AssertNotError(t, err, "Couldn't count rows")
+	return n
+}
+
+func TestPurge(t *testing.T) {
+	p, ssa, cleanup := setup(t)
+	defer cleanup()
+
+	old := newPending(t, ssa, -48*time.Hour)
+	older := newPending(t, ssa, -72*time.Hour)
+	newPending(t, ssa, -time.Hour)
+	newPending(t, ssa, time.Hour)
+
+	final := newPending(t, ssa, time.Hour)
+	exp := time.Now().Add(-48 * time.Hour)
+	final.Expires = &exp
+	final.Status = core.StatusValid
+	err := ssa.FinalizeAuthorization(final)
+	test.AssertNotError(t, err, "Couldn't finalize authorization")
+
+	var archive bytes.Buffer
+	p.archive = json.NewEncoder(&archive)
+
+	// Only rows that expired before the cutoff are purged, a batch at a time
+	cutoff := time.Now().Add(-24 * time.Hour)
+	purged, err := p.purge("pending_authz", cutoff)
+	test.AssertNotError(t, err, "Couldn't purge pending authorizations")
+	test.AssertEquals(t, purged, 2)
+	test.AssertEquals(t, count(t, p, "pending_authz"), int64(2))
+
+	purged, err = p.purge("authz", cutoff)
+	test.AssertNotError(t, err, "Couldn't purge authorizations")
+	test.AssertEquals(t, purged, 1)
+	test.AssertEquals(t, count(t, p, "authz"), int64(0))
+
+	// Purged rows are archived, oldest first
+	decoder := json.NewDecoder(&archive)
+	for _, expected := range []archivedAuthz{
+		{Table: "pending_authz", Authorization: older},
+		{Table: "pending_authz", Authorization: old},
+		{Table: "authz", Authorization: final},
+	} {
+		var archived archivedAuthz
+		err = decoder.Decode(&archived)
+		test.AssertNotError(t, err, "Couldn't decode archived authorization")
+		test.AssertEquals(t, archived.Table, expected.Table)
+		test.AssertEquals(t, archived.Authorization.ID, expected.Authorization.ID)
+		test.AssertEquals(t, archived.Authorization.Identifier, expected.Authorization.Identifier)
+	}
+	test.Assert(t, !decoder.More(), "Unexpected rows in the archive")
+}
+
+func TestBackfillPendingExpiry(t *testing.T) {
+	p, ssa, cleanup := setup(t)
+	defer cleanup()
+
+	legacy := newPending(t, ssa, time.Hour)
+	current := newPending(t, ssa, time.Hour)
+	_, err := p.dbMap.Exec("UPDATE pending_authz SET expires = NULL WHERE id = ?", legacy.ID)
+	test.AssertNotError(t, err, "Couldn't clear expiry")
+
+	// Only authorizations without an expiry are given one
+	now := time.Now()
+	updated, err := p.backfillPendingExpiry(now)
+	test.AssertNotError(t, err, "Couldn't backfill expiry times")
+	test.AssertEquals(t, updated, int64(1))
+
+	authz, err := ssa.GetAuthorization(legacy.ID)
+	test.AssertNotError(t, err, "Couldn't get backfilled authorization")
+	test.Assert(t, authz.Expires != nil, "Backfilled authorization has no expiry")
+	test.Assert(t, authz.Expires.Sub(now.Add(p.pendingLifetime)) < time.Second, "Backfilled authorization has the wrong expiry")
+	authz, err = ssa.GetAuthorization(current.ID)
+	test.AssertNotError(t, err, "Couldn't get authorization")
+	test.Assert(t, authz.Expires.Before(now.Add(2*time.Hour)), "Existing expiry was changed")
+
+	updated, err = p.backfillPendingExpiry(now)
+	test.AssertNotError(t, err, "Couldn't backfill expiry times")
+	test.AssertEquals(t, updated, int64(0))
+
+	// Once the backfilled expiry has passed, the authorization is purged
+	_, err = p.dbMap.Exec("UPDATE pending_authz SET expires = NULL WHERE id = ?", legacy.ID)
+	test.AssertNotError(t, err, "Couldn't clear expiry")
+	p.pendingLifetime = -time.Hour
+	_, err = p.backfillPendingExpiry(now)
+	test.AssertNotError(t, err, "Couldn't backfill expiry times")
+	purged, err := p.purge("pending_authz", now)
+	test.AssertNotError(t, err, "Couldn't purge pending authorizations")
+	test.AssertEquals(t, purged, 1)
+	_, err = ssa.GetAuthorization(legacy.ID)
+	test.AssertError(t, err, "Purged authorization still exists")
+}
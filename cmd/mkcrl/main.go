@@ -9,14 +9,18 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"strings"
 	"time"
 
-	//	"github.com/cloudflare/cfssl/crypto/pkcs11key"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/log"
+
+	"github.com/letsencrypt/boulder/ca"
+	"github.com/letsencrypt/boulder/core"
 )
 
 var certFile = flag.String("ca", "", "JSON file for subject and validity")
 var listFile = flag.String("revoked", "", "JSON file with a list of pkix.RevokedCertificate objects")
+var keyFile = flag.String("key", "", "PEM private key file, instead of a PKCS#11 key")
 var module = flag.String("pkcs11-module", "", "PKCS#11 module")
 var pin = flag.String("pkcs11-pin", "", "PKCS#11 password")
 var token = flag.String("pkcs11-token", "", "PKCS#11 token name")
@@ -31,16 +35,20 @@ type Config struct {
 
 func main() {
 	// Validate input
-	// All flags are required
+	// All flags are required, except that a key file replaces the PKCS#11
+	// flags
 	flag.Parse()
 	missing := false
 	flag.VisitAll(func(f *flag.Flag) {
+		if f.Name == "key" || (*keyFile != "" && strings.HasPrefix(f.Name, "pkcs11-")) {
+			return
+		}
 		if len(f.Value.String()) == 0 {
 			missing = true
 		}
 	})
 	if missing {
-		log.Critical("All flags must be provided.")
+		log.Critical("All flags must be provided, or -key instead of the PKCS#11 flags.")
 		flag.Usage()
 		return
 	}
@@ -73,14 +81,36 @@ func main() {
 		return
 	}
 
-	// Set up PKCS#11 key
-	priv, err := pkcs11key.New(*module, *token, *pin, *label)
+	// Set up the private key, which can be a file or a PKCS#11 key
+	priv, err := ca.LoadKey(ca.KeyConfig{
+		File: *keyFile,
+		PKCS11: ca.PKCS11Config{
+			Module: *module,
+			Token:  *token,
+			PIN:    *pin,
+			Label:  *label,
+		},
+	})
+	if err != nil {
+		log.Criticalf("Unable to load private key: %v", err)
+		return
+	}
+
+	// Choose the signature algorithm the CA uses for the key
+	sigAlg, err := core.SignatureAlgorithmForKey(priv.Public())
 	if err != nil {
-		log.Criticalf("Unable to instantiate PKCS#11 private key: %v", err)
+		log.Criticalf("Unable to sign with private key: %v", err)
+		return
+	}
+
+	if !core.KeyDigestEquals(priv.Public(), cert.PublicKey) {
+		log.Critical("Private key does not match the issuer certificate")
 		return
 	}
 
-	// Sign the CRL
+	// Sign the CRL. CreateCRL picks the signature algorithm from the key, and
+	// agrees with SignatureAlgorithmForKey for the keys that allows.
+	log.Infof("Signing CRL with %v", sigAlg)
 	crlDER, err := cert.CreateCRL(rand.Reader, priv, config.RevokedCerts, config.ThisUpdate, config.NextUpdate)
 	if err != nil {
 		log.Criticalf("Error signing certificate: %v", err)
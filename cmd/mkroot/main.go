@@ -11,13 +11,17 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/big"
+	"strings"
 	"time"
 
-	//	"github.com/cloudflare/cfssl/crypto/pkcs11key"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cloudflare/cfssl/log"
+
+	"github.com/letsencrypt/boulder/ca"
+	"github.com/letsencrypt/boulder/core"
 )
 
 var configFile = flag.String("config", "", "JSON file for subject and validity")
+var keyFile = flag.String("key", "", "PEM private key file, instead of a PKCS#11 key")
 var module = flag.String("pkcs11-module", "", "PKCS#11 module")
 var pin = flag.String("pkcs11-pin", "", "PKCS#11 password")
 var token = flag.String("pkcs11-token", "", "PKCS#11 token name")
@@ -37,16 +41,20 @@ type Config struct {
 
 func main() {
 	// Validate input
-	// All flags are required
+	// All flags are required, except that a key file replaces the PKCS#11
+	// flags
 	flag.Parse()
 	missing := false
 	flag.VisitAll(func(f *flag.Flag) {
+		if f.Name == "key" || (*keyFile != "" && strings.HasPrefix(f.Name, "pkcs11-")) {
+			return
+		}
 		if len(f.Value.String()) == 0 {
 			missing = true
 		}
 	})
 	if missing {
-		log.Critical("All flags must be provided.")
+		log.Critical("All flags must be provided, or -key instead of the PKCS#11 flags.")
 		flag.Usage()
 		return
 	}
@@ -75,10 +83,25 @@ func main() {
 		return
 	}
 
-	// Set up PKCS#11 key
-	priv, err := pkcs11key.New(*module, *token, *pin, *label)
+	// Set up the private key, which can be a file or a PKCS#11 key
+	priv, err := ca.LoadKey(ca.KeyConfig{
+		File: *keyFile,
+		PKCS11: ca.PKCS11Config{
+			Module: *module,
+			Token:  *token,
+			PIN:    *pin,
+			Label:  *label,
+		},
+	})
+	if err != nil {
+		log.Criticalf("Unable to load private key: %v", err)
+		return
+	}
+
+	// Choose the signature algorithm the CA uses for the key
+	sigAlg, err := core.SignatureAlgorithmForKey(priv.Public())
 	if err != nil {
-		log.Criticalf("Unable to instantiate PKCS#11 private key: %v", err)
+		log.Criticalf("Unable to sign with private key: %v", err)
 		return
 	}
 	pub := priv.Public()
@@ -103,7 +126,7 @@ func main() {
 
 	// Sign the certificate
 	rootTemplate := &x509.Certificate{
-		SignatureAlgorithm: x509.SHA256WithRSA,
+		SignatureAlgorithm: sigAlg,
 
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
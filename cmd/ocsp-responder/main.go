@@ -7,10 +7,15 @@ package main
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/asn1"
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
@@ -57,7 +62,7 @@ func HandlerTimer(handler http.Handler, stats statsd.Statter) http.Handler {
 }
 
 /*
-DBSource maps a given Database schema to a CA Key Hash, so we can pick
+DBSource maps a given Database schema to a set of issuers, so we can pick
 from among them when presented with OCSP requests for different certs.
 
 We assume that OCSP responses are stored in a very simple database table,
@@ -72,23 +77,55 @@ serialNumber field, since we will always query on it.
 
 */
 type DBSource struct {
-	dbMap     *gorp.DbMap
-	caKeyHash []byte
+	dbMap   *gorp.DbMap
+	issuers map[string]*x509.Certificate
 }
 
 // NewSourceFromDatabase produces a DBSource representing the binding of a
-// given DB schema to a CA key.
-func NewSourceFromDatabase(dbMap *gorp.DbMap, caKeyHash []byte) (src *DBSource, err error) {
-	src = &DBSource{dbMap: dbMap, caKeyHash: caKeyHash}
+// given DB schema to the CA's issuers.
+func NewSourceFromDatabase(dbMap *gorp.DbMap, issuers map[string]*x509.Certificate) (src *DBSource, err error) {
+	src = &DBSource{dbMap: dbMap, issuers: issuers}
 	return
 }
 
+// issuedBy returns true if the request's issuer name and key hashes are
+// those of the issuer certificate.
+func issuedBy(req *ocsp.Request, issuer *x509.Certificate) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false
+	}
+
+	h := req.HashAlgorithm.New()
+	h.Write(issuer.RawSubject)
+	if !bytes.Equal(h.Sum(nil), req.IssuerNameHash) {
+		return false
+	}
+	h = req.HashAlgorithm.New()
+	h.Write(spki.PublicKey.RightAlign())
+	return bytes.Equal(h.Sum(nil), req.IssuerKeyHash)
+}
+
 // Response is called by the HTTP server to handle a new OCSP request.
 func (src *DBSource) Response(req *ocsp.Request) (response []byte, present bool) {
 	log := blog.GetAuditLogger()
 
-	// Check that this request is for the proper CA
-	if bytes.Compare(req.IssuerKeyHash, src.caKeyHash) != 0 {
+	// Check that this request is for one of our issuers
+	issuerName := ""
+	for name, issuer := range src.issuers {
+		if issuedBy(req, issuer) {
+			issuerName = name
+			break
+		}
+	}
+	if issuerName == "" {
 		log.Debug(fmt.Sprintf("Request intended for CA Cert ID: %s", hex.EncodeToString(req.IssuerKeyHash)))
 		present = false
 		return
@@ -105,13 +142,84 @@ func (src *DBSource) Response(req *ocsp.Request) (response []byte, present bool)
 		return
 	}
 
-	log.Info(fmt.Sprintf("OCSP Response sent for CA=%s, Serial=%s", hex.EncodeToString(src.caKeyHash), serialString))
+	log.Info(fmt.Sprintf("OCSP Response sent for CA=%s, Serial=%s", issuerName, serialString))
 
 	response = ocspResponse.Response
 	present = true
 	return
 }
 
+// CRLHandler serves the latest CRL stored for each issuer, at
+// <path>/<issuer>. The issuer may be left out if there is only one.
+type CRLHandler struct {
+	dbMap   *gorp.DbMap
+	issuers map[string]*x509.Certificate
+	path    string
+}
+
+// parsePath returns the issuer of the CRL a request path asks for.
+func (h *CRLHandler) parsePath(path string) (issuer string, ok bool) {
+	rest := strings.Trim(strings.TrimPrefix(path, h.path), "/")
+	if rest == "" {
+		if len(h.issuers) != 1 {
+			return "", false
+		}
+		for name := range h.issuers {
+			issuer = name
+		}
+		return issuer, true
+	}
+	if _, known := h.issuers[rest]; !known {
+		return "", false
+	}
+	return rest, true
+}
+
+func (h *CRLHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := blog.GetAuditLogger()
+
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	issuer, ok := h.parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var crl core.CRL
+	err := h.dbMap.SelectOne(&crl, "SELECT * FROM crls WHERE issuer = ? ORDER BY number DESC LIMIT 1", issuer)
+	if err == sql.ErrNoRows {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		log.Err(fmt.Sprintf("Error loading CRL for CA=%s: %s", issuer, err))
+		http.Error(w, "Error loading CRL", http.StatusInternalServerError)
+		return
+	}
+
+	// Caches may keep the CRL until its nextUpdate, when a newer one should
+	// be available
+	maxAge := crl.NextUpdate.Sub(time.Now())
+	if maxAge < 0 {
+		log.Warning(fmt.Sprintf("Serving expired CRL for CA=%s, Number=%d", issuer, crl.Number))
+		maxAge = 0
+	}
+	digest := sha256.Sum256(crl.CRL)
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	w.Header().Set("ETag", fmt.Sprintf("\"%X\"", digest))
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d, no-transform", int(maxAge.Seconds())))
+	w.Header().Set("Expires", crl.NextUpdate.UTC().Format(http.TimeFormat))
+
+	log.Info(fmt.Sprintf("CRL sent for CA=%s, Number=%d", issuer, crl.Number))
+
+	// ServeContent sets Last-Modified and answers conditional requests
+	http.ServeContent(w, r, "", crl.ThisUpdate, bytes.NewReader(crl.CRL))
+}
+
 func main() {
 	app := cmd.NewAppShell("boulder-ocsp-responder")
 	app.Action = func(c cmd.Config) {
@@ -136,20 +244,29 @@ func main() {
 		cmd.FailOnError(err, "Could not connect to database")
 		sa.SetSQLDebug(dbMap, c.SQL.SQLDebug)
 
-		// Load the CA's key so we can store its AuthorityKeyId in the DB
-		caCertDER, err := cmd.LoadCert(c.Common.IssuerCert)
-		cmd.FailOnError(err, fmt.Sprintf("Couldn't read issuer cert [%s]", c.Common.IssuerCert))
-		caCert, err := x509.ParseCertificate(caCertDER)
-		cmd.FailOnError(err, fmt.Sprintf("Couldn't parse cert read from [%s]", c.Common.IssuerCert))
+		// Load the CA's issuer certificates so that requests can be matched
+		// against their names and keys
+		issuers := make(map[string]*x509.Certificate)
+		for name, der := range cmd.IssuerCerts(c) {
+			issuers[name], err = x509.ParseCertificate(der)
+			cmd.FailOnError(err, fmt.Sprintf("Couldn't parse cert for issuer %s", name))
+			auditlogger.Info(fmt.Sprintf("Loading OCSP Database for issuer %s [%s]", name, issuers[name].Subject.CommonName))
+		}
 
 		// Construct source from DB
-		auditlogger.Info(fmt.Sprintf("Loading OCSP Database for CA Cert ID: %s", hex.EncodeToString(caCert.AuthorityKeyId)))
-		src, err := NewSourceFromDatabase(dbMap, caCert.AuthorityKeyId)
+		src, err := NewSourceFromDatabase(dbMap, issuers)
 		cmd.FailOnError(err, "Could not connect to OCSP database")
 
 		// Configure HTTP
 		http.Handle(c.OCSPResponder.Path, cfocsp.Responder{Source: src})
 
+		if c.OCSPResponder.CRLPath != "" {
+			crlPath := strings.TrimSuffix(c.OCSPResponder.CRLPath, "/")
+			crlHandler := &CRLHandler{dbMap: dbMap, issuers: issuers, path: crlPath}
+			http.Handle(crlPath, crlHandler)
+			http.Handle(crlPath+"/", crlHandler)
+		}
+
 		// Add HandlerTimer to output resp time + success/failure stats to statsd
 		auditlogger.Info(fmt.Sprintf("Server running, listening on %s...\n", c.OCSPResponder.ListenAddress))
 		err = http.ListenAndServe(c.OCSPResponder.ListenAddress, HandlerTimer(http.DefaultServeMux, stats))
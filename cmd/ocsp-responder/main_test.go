@@ -0,0 +1,130 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/mattn/go-sqlite3"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/sa"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// setupCRLHandler returns a CRL handler for the named issuers, serving from a
+// database in a temporary directory, which the returned function removes.
+func setupCRLHandler(t *testing.T, issuers ...string) (*CRLHandler, func()) {
+	dir, err := ioutil.TempDir("", "ocsp-responder")
+	test.AssertNotError(t, err, "Couldn't create temporary directory")
+	dbMap, err := sa.NewDbMap("sqlite3", filepath.Join(dir, "boulder.db"))
+	test.AssertNotError(t, err, "Couldn't connect to database")
+	err = dbMap.CreateTablesIfNotExists()
+	test.AssertNotError(t, err, "Couldn't create tables")
+
+	h := &CRLHandler{dbMap: dbMap, issuers: make(map[string]*x509.Certificate), path: "/crl"}
+	for _, name := range issuers {
+		h.issuers[name] = &x509.Certificate{}
+	}
+	return h, func() { os.RemoveAll(dir) }
+}
+
+// addCRL stores a CRL for an issuer, and returns it.
+func addCRL(t *testing.T, h *CRLHandler, issuer string, number int64) core.CRL {
+	thisUpdate := time.Now().Add(-time.Hour).Truncate(time.Second)
+	crl := core.CRL{
+		Issuer:     issuer,
+		Number:     number,
+		ThisUpdate: thisUpdate,
+		NextUpdate: thisUpdate.Add(25 * time.Hour),
+		CreatedAt:  thisUpdate,
+		CRL:        []byte(fmt.Sprintf("%s %d", issuer, number)),
+	}
+	err := h.dbMap.Insert(&crl)
+	test.AssertNotError(t, err, "Couldn't store CRL")
+	return crl
+}
+
+func get(h *CRLHandler, path string, header http.Header) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("GET", "http://localhost"+path, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestCRLHandler(t *testing.T) {
+	h, cleanup := setupCRLHandler(t, "one")
+	defer cleanup()
+	addCRL(t, h, "one", 1)
+	latest := addCRL(t, h, "one", 2)
+
+	// The latest CRL is served, with the headers caches need
+	w := get(h, "/crl/one", nil)
+	test.AssertEquals(t, w.Code, http.StatusOK)
+	test.AssertEquals(t, w.Body.String(), string(latest.CRL))
+	test.AssertEquals(t, w.Header().Get("Content-Type"), "application/pkix-crl")
+	etag := fmt.Sprintf("\"%X\"", sha256.Sum256(latest.CRL))
+	test.AssertEquals(t, w.Header().Get("ETag"), etag)
+	test.AssertEquals(t, w.Header().Get("Last-Modified"), latest.ThisUpdate.UTC().Format(http.TimeFormat))
+	test.AssertEquals(t, w.Header().Get("Expires"), latest.NextUpdate.UTC().Format(http.TimeFormat))
+	cacheControl := w.Header().Get("Cache-Control")
+	test.Assert(t, strings.HasPrefix(cacheControl, "public, max-age="), "Unexpected Cache-Control: "+cacheControl)
+	var maxAge int
+	fmt.Sscanf(cacheControl, "public, max-age=%d", &maxAge)
+	test.Assert(t, maxAge > 23*60*60 && maxAge <= 24*60*60, "max-age doesn't end at nextUpdate: "+cacheControl)
+
+	// Conditional requests for an unchanged CRL get no body
+	w = get(h, "/crl/one", http.Header{"If-None-Match": {etag}})
+	test.AssertEquals(t, w.Code, http.StatusNotModified)
+	test.AssertEquals(t, w.Body.Len(), 0)
+	w = get(h, "/crl/one", http.Header{"If-Modified-Since": {latest.ThisUpdate.UTC().Format(http.TimeFormat)}})
+	test.AssertEquals(t, w.Code, http.StatusNotModified)
+	w = get(h, "/crl/one", http.Header{"If-None-Match": {"\"stale\""}})
+	test.AssertEquals(t, w.Code, http.StatusOK)
+
+	// The only issuer may be left out of the path
+	for _, path := range []string{"/crl", "/crl/"} {
+		w = get(h, path, nil)
+		test.AssertEquals(t, w.Code, http.StatusOK)
+		test.AssertEquals(t, w.Body.String(), string(latest.CRL))
+	}
+
+	// Unknown issuers, and paths below an issuer, aren't found
+	for _, path := range []string{"/crl/two", "/crl/one/0", "/crl/one/one"} {
+		test.AssertEquals(t, get(h, path, nil).Code, http.StatusNotFound)
+	}
+
+	req, _ := http.NewRequest("POST", "http://localhost/crl/one", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	test.AssertEquals(t, w.Code, http.StatusMethodNotAllowed)
+}
+
+func TestCRLHandlerMultipleIssuers(t *testing.T) {
+	h, cleanup := setupCRLHandler(t, "one", "two")
+	defer cleanup()
+	addCRL(t, h, "one", 1)
+	two := addCRL(t, h, "two", 1)
+
+	// The issuer can only be left out if there is just one
+	test.AssertEquals(t, get(h, "/crl", nil).Code, http.StatusNotFound)
+	w := get(h, "/crl/two", nil)
+	test.AssertEquals(t, w.Code, http.StatusOK)
+	test.AssertEquals(t, w.Body.String(), string(two.CRL))
+}
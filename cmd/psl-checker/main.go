@@ -0,0 +1,105 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// psl-checker validates a new copy of public_suffix_list.dat before it is
+// swapped in for the file named by the PA's PublicSuffixListFile setting.
+// It exits non-zero if the new list fails to parse, is implausibly small, or
+// mishandles well-known suffixes. If the current list is given, the rules
+// added and removed are printed for review.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/letsencrypt/boulder/policy"
+)
+
+var newFile = flag.String("new", "", "Path to the candidate public_suffix_list.dat (required)")
+var currentFile = flag.String("current", "", "Path to the public suffix list currently in use")
+var minRules = flag.Int("min-rules", 5000, "Minimum number of rules expected in the new list")
+
+// Well-known names and the public suffix each must have under any sane list
+var sanityChecks = map[string]string{
+	"example.com":          "com",
+	"example.org":          "org",
+	"example.co.uk":        "co.uk",
+	"example.ac.jp":        "ac.jp",
+	"www.city.kawasaki.jp": "kawasaki.jp",
+	"foo.bar.kawasaki.jp":  "bar.kawasaki.jp",
+}
+
+func loadRules(path string) *policy.PublicSuffixRules {
+	file, err := os.Open(path)
+	if err != nil {
+		fail(fmt.Sprintf("Couldn't open %s: %s", path, err))
+	}
+	defer file.Close()
+
+	rules, err := policy.ParsePublicSuffixRules(file)
+	if err != nil {
+		fail(fmt.Sprintf("Couldn't parse %s: %s", path, err))
+	}
+	return rules
+}
+
+func fail(msg string) {
+	fmt.Fprintf(os.Stderr, "FAIL: %s\n", msg)
+	os.Exit(1)
+}
+
+// difference returns the rules in a that are not in b, sorted
+func difference(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, rule := range b {
+		inB[rule] = true
+	}
+	var diff []string
+	for _, rule := range a {
+		if !inB[rule] {
+			diff = append(diff, rule)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+func main() {
+	flag.Parse()
+	if *newFile == "" {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	candidate := loadRules(*newFile)
+	fmt.Printf("%s: %d rules\n", *newFile, candidate.Len())
+	if candidate.Len() < *minRules {
+		fail(fmt.Sprintf("Only %d rules found; expected at least %d", candidate.Len(), *minRules))
+	}
+
+	for name, expected := range sanityChecks {
+		if suffix, explicit := candidate.PublicSuffix(name); !explicit || suffix != expected {
+			fail(fmt.Sprintf("Public suffix of %s is %s (explicit: %v); expected %s", name, suffix, explicit, expected))
+		}
+	}
+
+	if *currentFile != "" {
+		current := loadRules(*currentFile)
+		added := difference(candidate.Rules(), current.Rules())
+		removed := difference(current.Rules(), candidate.Rules())
+		for _, rule := range added {
+			fmt.Printf("+ %s\n", rule)
+		}
+		// Removed suffixes become eligible for issuance, so call them out
+		for _, rule := range removed {
+			fmt.Printf("- %s\n", rule)
+		}
+		fmt.Printf("%d rules added, %d rules removed\n", len(added), len(removed))
+	}
+
+	fmt.Println("OK")
+}
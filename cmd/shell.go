@@ -27,6 +27,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"runtime"
 	"time"
@@ -36,15 +37,21 @@ import (
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/streadway/amqp"
 	"github.com/letsencrypt/boulder/ca"
 	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/ct"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mail"
+	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/ra"
 	"github.com/letsencrypt/boulder/rpc"
+	"github.com/letsencrypt/boulder/va"
 )
 
 // Config stores configuration parameters that applications
 // will need.  For simplicity, we just lump them all into
 // one struct, and use encoding/json to read it from a file.
 //
-// Note: NO DEFAULTS are provided.
+// Note: Most settings have no defaults.  Where a setting's comment names a
+// default, it applies when the setting is left empty or zero.
 type Config struct {
 	// General
 	AMQP struct {
@@ -61,16 +68,87 @@ type Config struct {
 		ListenAddress string
 	}
 
+	RA struct {
+		// CAA is rechecked at issuance for authorizations whose CAA check is
+		// older than this. If empty, CAA is always rechecked.
+		CAARecheckWindow string
+
+		// How long valid authorizations can be used, by default and for
+		// particular challenge types. If AuthorizationLifetime is empty,
+		// ra.DefaultAuthorizationLifetime is used.
+		AuthorizationLifetime  string
+		AuthorizationLifetimes map[string]string
+
+		// The issuance profile used for requests that don't name one, and
+		// the registrations allowed to use restricted profiles (see
+		// ra.RegistrationAuthorityImpl).
+		DefaultProfile    string
+		ProfileAllowLists map[string][]int64
+
+		// The registrations allowed OCSP Must-Staple certificates
+		MustStapleAllowList []int64
+	}
+
 	CA ca.Config
 
 	SA struct {
 		DBDriver string
 		DBName   string
+
+		// How long new pending authorizations can be used before they
+		// expire. If empty, sa.DefaultPendingAuthorizationLifetime is used.
+		// expired-authz-purger also gives this lifetime to older pending
+		// authorizations that have no expiry.
+		PendingAuthorizationLifetime string
+	}
+
+	PA struct {
+		// Path to a JSON file of blocked and allowed names (see
+		// policy.NameList). If empty, the compiled-in blacklist is used.
+		NameDBFile string
+		// How often to reload the name database, in addition to on SIGHUP.
+		NameDBReloadInterval string
+
+		// Path to a copy of the upstream public_suffix_list.dat. If empty,
+		// the compiled-in list is used.
+		PublicSuffixListFile string
+		// How often to check the public suffix list file for changes.
+		PublicSuffixListCheckInterval string
 	}
 
 	VA struct {
 		DNSResolver string
 		DNSTimeout  string
+
+		// Further DNS servers, which queries rotate across along with
+		// DNSResolver
+		DNSResolvers []string
+		// Attempts made for each DNS query, and the delay before the first
+		// retry (doubled for each further one)
+		DNSMaxTries     int
+		DNSRetryBackoff string
+		// Upper bounds on how long DNS responses, and negative responses in
+		// particular, are cached. "0s" disables caching.
+		DNSMaxCacheTTL         string
+		DNSMaxNegativeCacheTTL string
+		// DS or DNSKEY records, in zone file format, to validate DNSSEC
+		// from in process.  If empty, the upstream resolvers are trusted.
+		DNSSECTrustAnchors []string
+
+		// Look up CAA records for IP address identifiers in the reverse
+		// zone; if false, CAA checking is skipped for IP addresses.
+		CheckReverseZoneCAA bool
+
+		// Issuer domain names that identify this CA in CAA records
+		IssuerDomains []string
+
+		// CIDR blocks that validation refuses to connect to, replacing
+		// the default list of reserved ranges if set
+		ReservedAddressRanges []string
+
+		// Minimum time between CAA iodef reports for one registered domain.
+		// If empty, no reports are sent. Mail is sent using the Mail section.
+		IodefReportInterval string
 	}
 
 	SQL struct {
@@ -106,6 +184,9 @@ type Config struct {
 		DBName        string
 		Path          string
 		ListenAddress string
+		// If set, the latest CRLs are served under this path, at
+		// <CRLPath>/<issuer>
+		CRLPath string
 	}
 
 	OCSPUpdater struct {
@@ -115,9 +196,55 @@ type Config struct {
 		ResponseLimit   int
 	}
 
+	AuthzPurger struct {
+		DBDriver string
+		DBName   string
+
+		// Authorizations are purged once they have been expired for this
+		// long, this many rows at a time
+		GracePeriod string
+		BatchSize   int
+		// If set, purged rows are appended to this file as JSON, one per
+		// line, before they are deleted
+		ArchiveFile string
+	}
+
+	CTSubmitter struct {
+		DBDriver string
+		DBName   string
+
+		// The logs every issued certificate is submitted to. SCTsRequired
+		// doesn't apply: each log is tracked separately.
+		CT ct.Config
+
+		// How often to look for new certificates and due submissions, and
+		// how many of each to handle per poll
+		PollInterval string
+		BatchSize    int
+
+		// Failed submissions are retried after RetryBackoff, doubled for each
+		// further attempt up to MaxRetryBackoff, and given up on after
+		// MaxAttempts attempts
+		RetryBackoff    string
+		MaxRetryBackoff string
+		MaxAttempts     int
+	}
+
+	CRLGenerator struct {
+		DBDriver string
+		DBName   string
+
+		// How often new CRLs are signed, and how long after signing their
+		// nextUpdate is. UpdateInterval must be shorter than Validity.
+		UpdateInterval string
+		Validity       string
+	}
+
 	Common struct {
 		BaseURL string
-		// Path to a PEM-encoded copy of the issuer certificate.
+		// Path to a PEM-encoded copy of the issuer certificate. If the CA has
+		// several issuers, this is the one served to clients that don't ask
+		// for a particular one.
 		IssuerCert string
 		MaxKeySize int
 	}
@@ -246,6 +373,138 @@ func ProfileCmd(profileName string, stats statsd.Statter) {
 	}
 }
 
+// NewPolicyAuthority constructs a policy authority. If a name database or
+// public suffix list file is configured, it is loaded and kept up to date for
+// the life of the process.
+func NewPolicyAuthority(c Config) *policy.PolicyAuthorityImpl {
+	pa := policy.NewPolicyAuthorityImpl()
+
+	if c.PA.NameDBFile != "" {
+		var interval time.Duration
+		if c.PA.NameDBReloadInterval != "" {
+			var err error
+			interval, err = time.ParseDuration(c.PA.NameDBReloadInterval)
+			FailOnError(err, "Couldn't parse name database reload interval")
+		}
+
+		nameDB, err := policy.NewNameDB(c.PA.NameDBFile)
+		FailOnError(err, "Couldn't load name database")
+		go nameDB.ReloadForever(interval)
+
+		pa.NameDB = nameDB
+	}
+
+	pa.SuffixDB = NewPublicSuffixDB(c)
+
+	return pa
+}
+
+// NewPublicSuffixDB loads the public suffix list configured for the PA and
+// reloads it when it changes, or returns nil if none is configured.
+func NewPublicSuffixDB(c Config) *policy.PublicSuffixDB {
+	if c.PA.PublicSuffixListFile == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(c.PA.PublicSuffixListCheckInterval)
+	FailOnError(err, "Couldn't parse public suffix list check interval")
+
+	suffixDB, err := policy.NewPublicSuffixDB(c.PA.PublicSuffixListFile)
+	FailOnError(err, "Couldn't load public suffix list")
+	go suffixDB.ReloadForever(interval)
+	return suffixDB
+}
+
+// NewDNSResolver constructs the VA's DNS resolver from the configuration.
+// Settings left empty keep the resolver's defaults.
+func NewDNSResolver(c Config, stats statsd.Statter) *core.DNSResolver {
+	dnsTimeout, err := time.ParseDuration(c.VA.DNSTimeout)
+	FailOnError(err, "Couldn't parse DNS timeout")
+
+	var servers []string
+	if c.VA.DNSResolver != "" {
+		servers = append(servers, c.VA.DNSResolver)
+	}
+	servers = append(servers, c.VA.DNSResolvers...)
+
+	resolver := core.NewDNSResolver(dnsTimeout, servers)
+	resolver.Stats = stats
+	if c.VA.DNSMaxTries > 0 {
+		resolver.MaxTries = c.VA.DNSMaxTries
+	}
+	if c.VA.DNSRetryBackoff != "" {
+		resolver.RetryBackoff, err = time.ParseDuration(c.VA.DNSRetryBackoff)
+		FailOnError(err, "Couldn't parse DNS retry backoff")
+	}
+	if c.VA.DNSMaxCacheTTL != "" {
+		resolver.MaxCacheTTL, err = time.ParseDuration(c.VA.DNSMaxCacheTTL)
+		FailOnError(err, "Couldn't parse DNS cache TTL")
+	}
+	if c.VA.DNSMaxNegativeCacheTTL != "" {
+		resolver.MaxNegativeTTL, err = time.ParseDuration(c.VA.DNSMaxNegativeCacheTTL)
+		FailOnError(err, "Couldn't parse DNS negative cache TTL")
+	}
+	if len(c.VA.DNSSECTrustAnchors) > 0 {
+		resolver.Validator, err = core.NewDNSSECValidator(resolver, c.VA.DNSSECTrustAnchors)
+		FailOnError(err, "Couldn't load DNSSEC trust anchors")
+	}
+	return resolver
+}
+
+// ReservedAddressRanges parses the address ranges the VA refuses to connect
+// to, returning the defaults if none are configured.
+func ReservedAddressRanges(c Config) []*net.IPNet {
+	if len(c.VA.ReservedAddressRanges) == 0 {
+		return policy.ReservedNetworks
+	}
+	ranges, err := va.ParseAddressRanges(c.VA.ReservedAddressRanges)
+	FailOnError(err, "Couldn't parse reserved address ranges")
+	return ranges
+}
+
+// AuthorizationLifetimes parses the RA's default authorization lifetime and
+// its lifetimes for particular challenge types.
+func AuthorizationLifetimes(c Config) (time.Duration, map[string]time.Duration) {
+	lifetime := ra.DefaultAuthorizationLifetime
+	if c.RA.AuthorizationLifetime != "" {
+		var err error
+		lifetime, err = time.ParseDuration(c.RA.AuthorizationLifetime)
+		FailOnError(err, "Couldn't parse authorization lifetime")
+	}
+	lifetimes := make(map[string]time.Duration)
+	for challengeType, value := range c.RA.AuthorizationLifetimes {
+		challengeLifetime, err := time.ParseDuration(value)
+		FailOnError(err, fmt.Sprintf("Couldn't parse authorization lifetime for %s", challengeType))
+		lifetimes[challengeType] = challengeLifetime
+	}
+	return lifetime, lifetimes
+}
+
+// NewIodefReporter constructs the VA's CAA iodef reporter from the
+// configuration, or returns nil if reporting is not configured.  Reports are
+// limited per registered domain, and sent using the VA's DNS resolver and
+// reserved address ranges, so those must be set on vai first.
+func NewIodefReporter(c Config, vai va.ValidationAuthorityImpl) *va.IodefReporter {
+	if c.VA.IodefReportInterval == "" {
+		return nil
+	}
+	interval, err := time.ParseDuration(c.VA.IodefReportInterval)
+	FailOnError(err, "Couldn't parse iodef report interval")
+	if len(c.VA.IssuerDomains) == 0 {
+		FailOnError(errors.New("no issuer domains configured"), "Couldn't configure iodef reports")
+	}
+
+	var mailer *mail.Mailer
+	if c.Mail.Server != "" {
+		m := mail.NewMailer(c.Mail.Server, c.Mail.Port, c.Mail.Username, c.Mail.Password)
+		mailer = &m
+	}
+	reporter := va.NewIodefReporter(mailer, c.VA.IssuerDomains[0], interval)
+	reporter.RegisteredDomain = vai.RegisteredDomain
+	reporter.DNSResolver = vai.DNSResolver
+	reporter.ReservedRanges = vai.ReservedRanges
+	return reporter
+}
+
 // LoadCert loads a PEM-formatted certificate from the provided path, returning
 // it as a byte array, or an error if it couldn't be decoded.
 func LoadCert(path string) (cert []byte, err error) {
@@ -267,3 +526,23 @@ func LoadCert(path string) (cert []byte, err error) {
 	cert = block.Bytes
 	return
 }
+
+// IssuerCerts loads the certificates (DER) of the CA's issuers, by name. If
+// the CA has no issuers configured, Common.IssuerCert is loaded as
+// ca.DefaultIssuerName.
+func IssuerCerts(c Config) map[string][]byte {
+	issuers := c.CA.Issuers
+	if len(issuers) == 0 {
+		issuers = []ca.IssuerConfig{ca.IssuerConfig{
+			Name:     ca.DefaultIssuerName,
+			CertFile: c.Common.IssuerCert,
+		}}
+	}
+	certs := make(map[string][]byte)
+	for _, issuer := range issuers {
+		cert, err := LoadCert(issuer.CertFile)
+		FailOnError(err, fmt.Sprintf("Couldn't read issuer cert [%s]", issuer.CertFile))
+		certs[issuer.Name] = cert
+	}
+	return certs
+}
@@ -8,8 +8,13 @@ package core
 import (
 	"fmt"
 	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
 )
 
@@ -22,10 +27,44 @@ func (err DNSSECError) Error() string {
 	return "DNSSEC validation failure"
 }
 
-// DNSResolver represents a resolver system
+// Defaults for the DNSResolver's tunables
+const (
+	defaultDNSMaxTries       = 3
+	defaultDNSRetryBackoff   = 50 * time.Millisecond
+	defaultDNSMaxNegativeTTL = 5 * time.Minute
+	defaultDNSMaxCacheTTL    = time.Hour
+	defaultDNSCacheSize      = 10000
+)
+
+// DNSResolver represents a resolver system.  Queries made through Exchange
+// (and so LookupDNSSEC and LookupTXT) rotate across the configured servers,
+// are retried on network errors or SERVFAIL, fall back to TCP if the UDP response
+// was truncated, and are cached for the records' TTL.
 type DNSResolver struct {
 	DNSClient *dns.Client
 	Servers   []string
+
+	// Stats receives query, failure, and timing metrics for each server
+	Stats statsd.Statter
+
+	// MaxTries is the number of attempts made for a query, each to the next
+	// server in turn.  Retries are delayed by RetryBackoff, doubling each time.
+	MaxTries     int
+	RetryBackoff time.Duration
+
+	// MaxCacheTTL bounds how long any response is cached, and MaxNegativeTTL
+	// how long NXDOMAIN and empty responses are cached.  Zero disables caching
+	// of the respective responses.
+	MaxCacheTTL    time.Duration
+	MaxNegativeTTL time.Duration
+
+	// Validator, if set, validates the responses to LookupDNSSEC queries in
+	// process, instead of relying on the upstream resolver
+	Validator *DNSSECValidator
+
+	tcpClient *dns.Client
+	next      uint32 // index of the server to use first for the next query
+	cache     *dnsCache
 }
 
 // NewDNSResolver constructs a new DNS resolver object that utilizes the
@@ -36,7 +75,24 @@ func NewDNSResolver(dialTimeout time.Duration, servers []string) *DNSResolver {
 	// Set timeout for underlying net.Conn
 	dnsClient.DialTimeout = dialTimeout
 
-	return &DNSResolver{DNSClient: dnsClient, Servers: servers}
+	tcpClient := new(dns.Client)
+	tcpClient.Net = "tcp"
+	tcpClient.DialTimeout = dialTimeout
+
+	stats, _ := statsd.NewNoopClient(nil)
+
+	return &DNSResolver{
+		DNSClient:      dnsClient,
+		Servers:        servers,
+		Stats:          stats,
+		MaxTries:       defaultDNSMaxTries,
+		RetryBackoff:   defaultDNSRetryBackoff,
+		MaxCacheTTL:    defaultDNSMaxCacheTTL,
+		MaxNegativeTTL: defaultDNSMaxNegativeTTL,
+		tcpClient:      tcpClient,
+		next:           uint32(rand.Intn(len(servers) + 1)),
+		cache:          newDNSCache(defaultDNSCacheSize),
+	}
 }
 
 // ExchangeOne performs a single DNS exchange with a randomly chosen server
@@ -53,16 +109,139 @@ func (dnsResolver *DNSResolver) ExchangeOne(m *dns.Msg) (rsp *dns.Msg, rtt time.
 	return dnsResolver.DNSClient.Exchange(m, chosenServer)
 }
 
-// LookupDNSSEC sends the provided DNS message to a randomly chosen server (see
-// ExchangeOne) with DNSSEC enabled. If the lookup fails, this method sends a
+// serverStat returns the statsd name of a metric for a server, e.g.
+// "DNS.Server.8_8_8_8_53.Queries"
+func serverStat(server, metric string) string {
+	return fmt.Sprintf("DNS.Server.%s.%s", strings.NewReplacer(".", "_", ":", "_").Replace(server), metric)
+}
+
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+// exchangeWith sends the message to one server, retrying over TCP if the UDP
+// response was truncated.
+func (dnsResolver *DNSResolver) exchangeWith(m *dns.Msg, server string) (r *dns.Msg, rtt time.Duration, err error) {
+	stats := dnsResolver.Stats
+	stats.Inc(serverStat(server, "Queries"), 1, 1.0)
+	r, rtt, err = dnsResolver.DNSClient.Exchange(m, server)
+	if r != nil && r.Truncated {
+		stats.Inc(serverStat(server, "Truncated"), 1, 1.0)
+		r, rtt, err = dnsResolver.tcpClient.Exchange(m, server)
+	}
+
+	switch {
+	case isTimeout(err):
+		stats.Inc(serverStat(server, "Timeouts"), 1, 1.0)
+	case err != nil:
+		stats.Inc(serverStat(server, "Errors"), 1, 1.0)
+	case r.Rcode == dns.RcodeServerFailure:
+		stats.Inc(serverStat(server, "ServFail"), 1, 1.0)
+	default:
+		stats.TimingDuration(serverStat(server, "RTT"), rtt, 1.0)
+	}
+	return
+}
+
+// Exchange sends the message to the configured servers in turn until one
+// gives a response other than SERVFAIL, making up to MaxTries attempts, and
+// caches the result.  Timeouts, refused connections, and other network errors
+// move on to the next server just as SERVFAIL does.  If every attempt fails,
+// the last response or error is returned.
+func (dnsResolver *DNSResolver) Exchange(m *dns.Msg) (r *dns.Msg, rtt time.Duration, err error) {
+	if len(dnsResolver.Servers) < 1 {
+		err = fmt.Errorf("Not configured with at least one DNS Server")
+		return
+	}
+
+	key := dnsCacheKey(m)
+	if cached := dnsResolver.cache.get(key, m.Id); cached != nil {
+		dnsResolver.Stats.Inc("DNS.Cache.Hits", 1, 1.0)
+		return cached, 0, nil
+	}
+	dnsResolver.Stats.Inc("DNS.Cache.Misses", 1, 1.0)
+
+	tries := dnsResolver.MaxTries
+	if tries < 1 {
+		tries = 1
+	}
+	first := atomic.AddUint32(&dnsResolver.next, 1)
+	backoff := dnsResolver.RetryBackoff
+	for i := 0; i < tries; i++ {
+		if i > 0 {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		server := dnsResolver.Servers[(int(first)+i)%len(dnsResolver.Servers)]
+		r, rtt, err = dnsResolver.exchangeWith(m, server)
+		if err == nil && r.Rcode != dns.RcodeServerFailure {
+			dnsResolver.cacheResponse(key, r)
+			return
+		}
+	}
+	return
+}
+
+// cacheResponse caches successful and NXDOMAIN responses for the lowest TTL
+// of their records, bounded by MaxCacheTTL.  Negative responses are cached for
+// the SOA minimum (RFC 2308), bounded by MaxNegativeTTL.
+func (dnsResolver *DNSResolver) cacheResponse(key string, r *dns.Msg) {
+	if r.Truncated || (r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError) {
+		return
+	}
+
+	negative := r.Rcode == dns.RcodeNameError || len(r.Answer) == 0
+	ttl := dnsResolver.MaxCacheTTL
+	if negative {
+		ttl = dnsResolver.MaxNegativeTTL
+	}
+	soaFound := false
+	for _, section := range [][]dns.RR{r.Answer, r.Ns, r.Extra} {
+		for _, rr := range section {
+			hdr := rr.Header()
+			if hdr.Rrtype == dns.TypeOPT {
+				continue
+			}
+			rrTTL := time.Duration(hdr.Ttl) * time.Second
+			if soa, ok := rr.(*dns.SOA); ok && negative {
+				soaFound = true
+				if minTTL := time.Duration(soa.Minttl) * time.Second; minTTL < rrTTL {
+					rrTTL = minTTL
+				}
+			}
+			if rrTTL < ttl {
+				ttl = rrTTL
+			}
+		}
+	}
+	// Without an SOA there's no negative TTL to go by
+	if negative && !soaFound {
+		return
+	}
+	if ttl > 0 {
+		dnsResolver.cache.set(key, r, ttl)
+	}
+}
+
+// LookupDNSSEC sends the provided DNS message to the configured servers (see
+// Exchange) with DNSSEC enabled. If the lookup fails, this method sends a
 // clarification query to determine if it's because DNSSEC was invalid or just
 // a run-of-the-mill error. If it's because of DNSSEC, it returns ErrorDNSSEC.
 func (dnsResolver *DNSResolver) LookupDNSSEC(m *dns.Msg) (*dns.Msg, time.Duration, error) {
+	r, _, rtt, err := dnsResolver.LookupDNSSECStatus(m)
+	return r, rtt, err
+}
+
+// LookupDNSSECStatus is LookupDNSSEC, also returning the result of validating
+// the response if the resolver has a Validator.  Bogus responses give a
+// DNSSECError.
+func (dnsResolver *DNSResolver) LookupDNSSECStatus(m *dns.Msg) (*dns.Msg, DNSSECStatus, time.Duration, error) {
 	// Set DNSSEC OK bit
 	m.SetEdns0(4096, true)
-	r, rtt, err := dnsResolver.ExchangeOne(m)
+	r, rtt, err := dnsResolver.Exchange(m)
 	if err != nil {
-		return r, rtt, err
+		return r, DNSSECUnchecked, rtt, err
 	}
 
 	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError && r.Rcode != dns.RcodeNXRrset {
@@ -70,33 +249,49 @@ func (dnsResolver *DNSResolver) LookupDNSSEC(m *dns.Msg) (*dns.Msg, time.Duratio
 			// Re-send query with +cd to see if SERVFAIL was caused by DNSSEC
 			// validation failure at the resolver
 			m.CheckingDisabled = true
-			checkR, _, err := dnsResolver.ExchangeOne(m)
+			checkR, _, err := dnsResolver.Exchange(m)
 			if err != nil {
-				return r, rtt, err
+				return r, DNSSECUnchecked, rtt, err
 			}
 
 			if checkR.Rcode != dns.RcodeServerFailure {
 				// DNSSEC error, so we return the testable object.
 				err = DNSSECError{}
-				return r, rtt, err
+				return r, DNSSECBogus, rtt, err
 			}
 		}
 		err = fmt.Errorf("Invalid response code: %d-%s", r.Rcode, dns.RcodeToString[r.Rcode])
-		return r, rtt, err
+		return r, DNSSECUnchecked, rtt, err
 	}
 
-	return r, rtt, err
+	if dnsResolver.Validator == nil || len(m.Question) != 1 {
+		return r, DNSSECUnchecked, rtt, nil
+	}
+	status, err := dnsResolver.Validator.Validate(m.Question[0].Name, m.Question[0].Qtype, r)
+	if status == DNSSECBogus {
+		return r, status, rtt, DNSSECError{}
+	}
+	return r, status, rtt, err
 }
 
 // LookupTXT uses a DNSSEC-enabled query to find all TXT records associated with
 // the provided hostname. If the query fails due to DNSSEC, error will be
 // set to ErrorDNSSEC.
 func (dnsResolver *DNSResolver) LookupTXT(hostname string) ([]string, time.Duration, error) {
+	txt, _, rtt, err := dnsResolver.LookupTXTStatus(hostname)
+	return txt, rtt, err
+}
+
+// LookupTXTStatus is LookupTXT, also returning the DNSSEC validation result.
+func (dnsResolver *DNSResolver) LookupTXTStatus(hostname string) ([]string, DNSSECStatus, time.Duration, error) {
 	var txt []string
 
 	m := new(dns.Msg)
 	m.SetQuestion(dns.Fqdn(hostname), dns.TypeTXT)
-	r, rtt, err := dnsResolver.LookupDNSSEC(m)
+	r, status, rtt, err := dnsResolver.LookupDNSSECStatus(m)
+	if err != nil {
+		return txt, status, rtt, err
+	}
 
 	for _, answer := range r.Answer {
 		if answer.Header().Rrtype == dns.TypeTXT {
@@ -107,5 +302,132 @@ func (dnsResolver *DNSResolver) LookupTXT(hostname string) ([]string, time.Durat
 		}
 	}
 
-	return txt, rtt, err
+	return txt, status, rtt, err
+}
+
+// LookupHost uses DNSSEC-enabled queries to find the IPv6 and IPv4 addresses
+// of the provided hostname, returning the IPv6 addresses first.  A failure of
+// one of the lookups is only returned if the other finds no addresses, unless
+// it was due to DNSSEC.
+func (dnsResolver *DNSResolver) LookupHost(hostname string) ([]net.IP, time.Duration, error) {
+	var addrs []net.IP
+	var totalRTT time.Duration
+	var lookupErr error
+	for _, qtype := range []uint16{dns.TypeAAAA, dns.TypeA} {
+		m := new(dns.Msg)
+		m.SetQuestion(dns.Fqdn(hostname), qtype)
+		r, rtt, err := dnsResolver.LookupDNSSEC(m)
+		totalRTT += rtt
+		if _, ok := err.(DNSSECError); ok {
+			return nil, totalRTT, err
+		}
+		if err != nil {
+			lookupErr = err
+			continue
+		}
+
+		for _, answer := range r.Answer {
+			switch rr := answer.(type) {
+			case *dns.AAAA:
+				if qtype == dns.TypeAAAA {
+					addrs = append(addrs, rr.AAAA)
+				}
+			case *dns.A:
+				if qtype == dns.TypeA {
+					addrs = append(addrs, rr.A)
+				}
+			}
+		}
+	}
+	if len(addrs) == 0 && lookupErr != nil {
+		return nil, totalRTT, lookupErr
+	}
+	return addrs, totalRTT, nil
+}
+
+// dnsCache holds responses until their TTL expires
+type dnsCache struct {
+	sync.Mutex
+	maxEntries int
+	entries    map[string]dnsCacheEntry
+}
+
+type dnsCacheEntry struct {
+	msg     *dns.Msg
+	stored  time.Time
+	expires time.Time
+}
+
+func newDNSCache(maxEntries int) *dnsCache {
+	return &dnsCache{maxEntries: maxEntries, entries: map[string]dnsCacheEntry{}}
+}
+
+// dnsCacheKey identifies a query by its question and the DNSSEC-related flags
+// that change the response.
+func dnsCacheKey(m *dns.Msg) string {
+	if len(m.Question) != 1 {
+		return ""
+	}
+	q := m.Question[0]
+	do := false
+	if opt := m.IsEdns0(); opt != nil {
+		do = opt.Do()
+	}
+	return fmt.Sprintf("%s|%d|%d|%v|%v", strings.ToLower(q.Name), q.Qtype, q.Qclass, do, m.CheckingDisabled)
+}
+
+// get returns a copy of the cached response, with the given message ID and
+// TTLs reduced by the time spent in the cache, or nil.
+func (c *dnsCache) get(key string, id uint16) *dns.Msg {
+	if key == "" {
+		return nil
+	}
+	c.Lock()
+	entry, present := c.entries[key]
+	c.Unlock()
+	now := time.Now()
+	if !present || now.After(entry.expires) {
+		return nil
+	}
+
+	r := entry.msg.Copy()
+	r.Id = id
+	age := uint32(now.Sub(entry.stored) / time.Second)
+	for _, section := range [][]dns.RR{r.Answer, r.Ns, r.Extra} {
+		for _, rr := range section {
+			if hdr := rr.Header(); hdr.Rrtype != dns.TypeOPT {
+				if hdr.Ttl > age {
+					hdr.Ttl -= age
+				} else {
+					hdr.Ttl = 0
+				}
+			}
+		}
+	}
+	return r
+}
+
+func (c *dnsCache) set(key string, r *dns.Msg, ttl time.Duration) {
+	if key == "" {
+		return
+	}
+	now := time.Now()
+	c.Lock()
+	defer c.Unlock()
+	if _, present := c.entries[key]; !present && len(c.entries) >= c.maxEntries {
+		// Drop whatever has expired, and if that doesn't make room, the
+		// entry closest to expiring.
+		var soonest string
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			} else if soonest == "" || entry.expires.Before(c.entries[soonest].expires) {
+				soonest = k
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			delete(c.entries, soonest)
+		}
+	}
+	c.entries[key] = dnsCacheEntry{msg: r.Copy(), stored: now, expires: now.Add(ttl)}
 }
@@ -6,11 +6,14 @@
 package core
 
 import (
+	"net"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/letsencrypt/boulder/test"
 
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
 )
 
@@ -76,3 +79,346 @@ func TestDNSSEC(t *testing.T) {
 	test.Assert(t, !ok, "Shouldn't have been a DNSSECError")
 
 }
+
+// countingStats records the counters incremented through it
+type countingStats struct {
+	statsd.Statter
+	sync.Mutex
+	counts map[string]int64
+}
+
+func newCountingStats() *countingStats {
+	noop, _ := statsd.NewNoopClient(nil)
+	return &countingStats{Statter: noop, counts: map[string]int64{}}
+}
+
+func (s *countingStats) Inc(stat string, value int64, rate float32) error {
+	s.Lock()
+	defer s.Unlock()
+	s.counts[stat] += value
+	return nil
+}
+
+func (s *countingStats) count(stat string) int64 {
+	s.Lock()
+	defer s.Unlock()
+	return s.counts[stat]
+}
+
+// testDNSServer serves the handler over UDP and TCP on the same local port
+type testDNSServer struct {
+	addr    string
+	udp     *dns.Server
+	tcp     *dns.Server
+	mu      sync.Mutex
+	queries int
+}
+
+func startTestDNSServer(t *testing.T, handler func(w dns.ResponseWriter, r *dns.Msg)) *testDNSServer {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen on UDP")
+	l, err := net.Listen("tcp", pc.LocalAddr().String())
+	test.AssertNotError(t, err, "Failed to listen on TCP")
+
+	s := &testDNSServer{addr: pc.LocalAddr().String()}
+	counted := dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		if len(r.Question) != 1 {
+			return
+		}
+		s.mu.Lock()
+		s.queries++
+		s.mu.Unlock()
+		handler(w, r)
+	})
+	// A short read timeout keeps Shutdown from waiting long
+	s.udp = &dns.Server{PacketConn: pc, Handler: counted, ReadTimeout: 100 * time.Millisecond}
+	s.tcp = &dns.Server{Listener: l, Handler: counted, ReadTimeout: 100 * time.Millisecond}
+	go s.udp.ActivateAndServe()
+	go s.tcp.ActivateAndServe()
+	return s
+}
+
+func (s *testDNSServer) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queries
+}
+
+func (s *testDNSServer) stop() {
+	s.udp.Shutdown()
+	s.tcp.Shutdown()
+}
+
+func answerA(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	m.Answer = append(m.Answer, &dns.A{
+		Hdr: dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+		A:   net.ParseIP("192.0.2.1"),
+	})
+	w.WriteMsg(m)
+}
+
+func answerServFail(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetRcode(r, dns.RcodeServerFailure)
+	w.WriteMsg(m)
+}
+
+func aQuery(name string) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), dns.TypeA)
+	return m
+}
+
+func TestDNSRotation(t *testing.T) {
+	a := startTestDNSServer(t, answerA)
+	defer a.stop()
+	b := startTestDNSServer(t, answerA)
+	defer b.stop()
+
+	stats := newCountingStats()
+	resolver := NewDNSResolver(time.Second, []string{a.addr, b.addr})
+	resolver.Stats = stats
+	for _, name := range []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com"} {
+		_, _, err := resolver.Exchange(aQuery(name))
+		test.AssertNotError(t, err, "Query failed")
+	}
+	test.AssertEquals(t, a.count(), 2)
+	test.AssertEquals(t, b.count(), 2)
+	test.AssertEquals(t, stats.count(serverStat(a.addr, "Queries")), int64(2))
+	test.AssertEquals(t, stats.count(serverStat(b.addr, "Queries")), int64(2))
+}
+
+func TestDNSRetryServFail(t *testing.T) {
+	bad := startTestDNSServer(t, answerServFail)
+	defer bad.stop()
+	good := startTestDNSServer(t, answerA)
+	defer good.stop()
+
+	stats := newCountingStats()
+	resolver := NewDNSResolver(time.Second, []string{bad.addr, good.addr})
+	resolver.Stats = stats
+	resolver.RetryBackoff = time.Millisecond
+	for _, name := range []string{"a.example.com", "b.example.com"} {
+		r, _, err := resolver.Exchange(aQuery(name))
+		test.AssertNotError(t, err, "Query failed")
+		test.AssertEquals(t, r.Rcode, dns.RcodeSuccess)
+		test.AssertEquals(t, len(r.Answer), 1)
+	}
+	test.AssertEquals(t, stats.count(serverStat(bad.addr, "ServFail")), int64(1))
+
+	// With every server failing, the last SERVFAIL is returned
+	resolver.Servers = []string{bad.addr}
+	r, _, err := resolver.Exchange(aQuery("c.example.com"))
+	test.AssertNotError(t, err, "Query failed")
+	test.AssertEquals(t, r.Rcode, dns.RcodeServerFailure)
+	test.AssertEquals(t, stats.count(serverStat(bad.addr, "ServFail")), int64(1+resolver.MaxTries))
+}
+
+func TestDNSRetryTimeout(t *testing.T) {
+	// A server that never answers
+	silent, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen on UDP")
+	defer silent.Close()
+	good := startTestDNSServer(t, answerA)
+	defer good.stop()
+
+	stats := newCountingStats()
+	resolver := NewDNSResolver(time.Second, []string{silent.LocalAddr().String(), good.addr})
+	resolver.DNSClient.ReadTimeout = 50 * time.Millisecond
+	resolver.Stats = stats
+	resolver.RetryBackoff = time.Millisecond
+	for _, name := range []string{"a.example.com", "b.example.com"} {
+		r, _, err := resolver.Exchange(aQuery(name))
+		test.AssertNotError(t, err, "Query failed")
+		test.AssertEquals(t, len(r.Answer), 1)
+	}
+	test.AssertEquals(t, stats.count(serverStat(silent.LocalAddr().String(), "Timeouts")), int64(1))
+
+	resolver.Servers = []string{silent.LocalAddr().String()}
+	_, _, err = resolver.Exchange(aQuery("c.example.com"))
+	test.AssertError(t, err, "Query to a silent server succeeded")
+}
+
+func TestDNSRetryNetworkError(t *testing.T) {
+	// Nothing listens on this port, so queries to it are refused
+	closed, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen on UDP")
+	refusing := closed.LocalAddr().String()
+	closed.Close()
+	good := startTestDNSServer(t, answerA)
+	defer good.stop()
+
+	stats := newCountingStats()
+	resolver := NewDNSResolver(time.Second, []string{refusing, good.addr})
+	resolver.Stats = stats
+	resolver.RetryBackoff = time.Millisecond
+	for _, name := range []string{"a.example.com", "b.example.com"} {
+		r, _, err := resolver.Exchange(aQuery(name))
+		test.AssertNotError(t, err, "Query failed")
+		test.AssertEquals(t, len(r.Answer), 1)
+	}
+	test.AssertEquals(t, stats.count(serverStat(refusing, "Errors")), int64(1))
+	test.AssertEquals(t, good.count(), 2)
+}
+
+func TestDNSTruncated(t *testing.T) {
+	server := startTestDNSServer(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		if w.RemoteAddr().Network() == "tcp" {
+			answerA(w, r)
+			return
+		}
+		m := new(dns.Msg)
+		m.SetReply(r)
+		m.Truncated = true
+		w.WriteMsg(m)
+	})
+	defer server.stop()
+
+	stats := newCountingStats()
+	resolver := NewDNSResolver(time.Second, []string{server.addr})
+	resolver.Stats = stats
+	r, _, err := resolver.Exchange(aQuery("example.com"))
+	test.AssertNotError(t, err, "Query failed")
+	test.Assert(t, !r.Truncated, "Truncated response returned")
+	test.AssertEquals(t, len(r.Answer), 1)
+	test.AssertEquals(t, server.count(), 2)
+	test.AssertEquals(t, stats.count(serverStat(server.addr, "Truncated")), int64(1))
+}
+
+func TestDNSCache(t *testing.T) {
+	server := startTestDNSServer(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		if r.Question[0].Name == "nx.example.com." {
+			m := new(dns.Msg)
+			m.SetRcode(r, dns.RcodeNameError)
+			m.Ns = append(m.Ns, &dns.SOA{
+				Hdr:    dns.RR_Header{Name: "example.com.", Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: 3600},
+				Ns:     "ns.example.com.",
+				Mbox:   "hostmaster.example.com.",
+				Minttl: 1800,
+			})
+			w.WriteMsg(m)
+			return
+		}
+		answerA(w, r)
+	})
+	defer server.stop()
+
+	resolver := NewDNSResolver(time.Second, []string{server.addr})
+	resolver.MaxNegativeTTL = time.Minute
+
+	m := aQuery("example.com")
+	_, _, err := resolver.Exchange(m)
+	test.AssertNotError(t, err, "Query failed")
+	m = aQuery("EXAMPLE.com")
+	r, _, err := resolver.Exchange(m)
+	test.AssertNotError(t, err, "Query failed")
+	test.AssertEquals(t, server.count(), 1)
+	test.AssertEquals(t, r.Id, m.Id)
+	test.AssertEquals(t, len(r.Answer), 1)
+
+	// Entries last for the TTL of the records
+	entry := resolver.cache.entries[dnsCacheKey(m)]
+	test.AssertEquals(t, entry.expires.Sub(entry.stored), 300*time.Second)
+
+	// Queries that differ in their DNSSEC flags aren't answered from the cache
+	m.SetEdns0(4096, true)
+	_, _, err = resolver.Exchange(m)
+	test.AssertNotError(t, err, "Query failed")
+	test.AssertEquals(t, server.count(), 2)
+
+	// Negative responses are cached, up to MaxNegativeTTL
+	for i := 0; i < 2; i++ {
+		r, _, err = resolver.Exchange(aQuery("nx.example.com"))
+		test.AssertNotError(t, err, "Query failed")
+		test.AssertEquals(t, r.Rcode, dns.RcodeNameError)
+	}
+	test.AssertEquals(t, server.count(), 3)
+	entry = resolver.cache.entries[dnsCacheKey(aQuery("nx.example.com"))]
+	test.AssertEquals(t, entry.expires.Sub(entry.stored), time.Minute)
+
+	// Expired entries aren't used
+	resolver.cache.entries[dnsCacheKey(aQuery("nx.example.com"))] = dnsCacheEntry{
+		msg:     entry.msg,
+		stored:  entry.stored.Add(-time.Hour),
+		expires: entry.expires.Add(-time.Hour),
+	}
+	_, _, err = resolver.Exchange(aQuery("nx.example.com"))
+	test.AssertNotError(t, err, "Query failed")
+	test.AssertEquals(t, server.count(), 4)
+
+	// SERVFAILs aren't cached
+	failing := startTestDNSServer(t, answerServFail)
+	defer failing.stop()
+	resolver = NewDNSResolver(time.Second, []string{failing.addr})
+	resolver.MaxTries = 1
+	resolver.Exchange(aQuery("example.com"))
+	resolver.Exchange(aQuery("example.com"))
+	test.AssertEquals(t, failing.count(), 2)
+}
+
+func TestDNSCacheEviction(t *testing.T) {
+	cache := newDNSCache(2)
+	r := new(dns.Msg)
+	cache.set("a", r, time.Hour)
+	cache.set("b", r, time.Minute)
+	cache.set("c", r, time.Hour)
+	test.AssertEquals(t, len(cache.entries), 2)
+	_, present := cache.entries["b"]
+	test.Assert(t, !present, "Entry closest to expiring wasn't evicted")
+	_, present = cache.entries["c"]
+	test.Assert(t, present, "New entry wasn't stored in a full cache")
+
+	// Expired entries go first
+	cache.entries["a"] = dnsCacheEntry{msg: r, expires: time.Now().Add(-time.Second)}
+	cache.set("d", r, time.Second)
+	_, present = cache.entries["a"]
+	test.Assert(t, !present, "Expired entry wasn't evicted")
+	_, present = cache.entries["c"]
+	test.Assert(t, present, "Unexpired entry was evicted while there were expired ones")
+
+	// Replacing an entry doesn't evict anything
+	cache.set("d", r, time.Hour)
+	test.AssertEquals(t, len(cache.entries), 2)
+}
+
+func TestDNSLookupHost(t *testing.T) {
+	server := startTestDNSServer(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		if r.Question[0].Qtype == dns.TypeAAAA {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			m.Answer = append(m.Answer, &dns.AAAA{
+				Hdr:  dns.RR_Header{Name: r.Question[0].Name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 300},
+				AAAA: net.ParseIP("2001:db8::1"),
+			})
+			w.WriteMsg(m)
+			return
+		}
+		answerA(w, r)
+	})
+	defer server.stop()
+
+	obj := NewDNSResolver(time.Second, []string{server.addr})
+	addrs, _, err := obj.LookupHost("example.com")
+	test.AssertNotError(t, err, "LookupHost failed")
+	test.AssertEquals(t, len(addrs), 2)
+	test.AssertEquals(t, addrs[0].String(), "2001:db8::1")
+	test.AssertEquals(t, addrs[1].String(), "192.0.2.1")
+
+	// A failed AAAA lookup doesn't prevent using the IPv4 addresses
+	v4Server := startTestDNSServer(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		if r.Question[0].Qtype == dns.TypeAAAA {
+			answerServFail(w, r)
+			return
+		}
+		answerA(w, r)
+	})
+	defer v4Server.stop()
+	obj = NewDNSResolver(time.Second, []string{v4Server.addr})
+	obj.RetryBackoff = time.Millisecond
+	addrs, _, err = obj.LookupHost("example.com")
+	test.AssertNotError(t, err, "LookupHost failed")
+	test.AssertEquals(t, len(addrs), 1)
+	test.AssertEquals(t, addrs[0].String(), "192.0.2.1")
+}
@@ -0,0 +1,722 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
+)
+
+// DNSSECStatus is the result of validating a DNS response (RFC 4035 Section
+// 4.3).
+type DNSSECStatus string
+
+// The possible DNSSEC validation results.  A response is unchecked if no
+// validator is configured, or it was an error response.
+const (
+	DNSSECUnchecked = DNSSECStatus("unchecked")
+	DNSSECSecure    = DNSSECStatus("secure")
+	DNSSECInsecure  = DNSSECStatus("insecure")
+	DNSSECBogus     = DNSSECStatus("bogus")
+)
+
+// Bound on the number of zone cuts followed from a name up to a trust anchor
+const maxDNSSECChainLength = 32
+
+// NSEC3 records with more hash iterations than this aren't hashed, and the
+// denials they make are treated as insecure (RFC 9276 Section 3.2), so that a
+// zone can't make each proof cost thousands of SHA-1 rounds.
+const maxNSEC3Iterations = 150
+
+// The algorithms whose signatures can be checked, and the DS digest types.
+// Zones signed only with other algorithms are treated as insecure.
+var supportedDNSSECAlgorithms = map[uint8]bool{
+	dns.RSASHA1:          true,
+	dns.RSASHA1NSEC3SHA1: true,
+	dns.RSASHA256:        true,
+	dns.RSASHA512:        true,
+	dns.ECDSAP256SHA256:  true,
+	dns.ECDSAP384SHA384:  true,
+}
+
+var supportedDSDigests = map[uint8]bool{
+	dns.SHA1:   true,
+	dns.SHA256: true,
+	dns.SHA384: true,
+}
+
+// DNSSECValidator validates DNS responses in process, building a chain of
+// trust from the configured trust anchors down to the signer of each answer,
+// rather than trusting the AD bit set by the upstream resolver.  Denial of
+// existence is checked with NSEC (RFC 4035) or NSEC3 (RFC 5155) proofs.
+type DNSSECValidator struct {
+	resolver *DNSResolver
+	anchors  map[string][]*dns.DS
+	clk      func() time.Time
+}
+
+// NewDNSSECValidator constructs a validator that fetches the keys it needs
+// through the given resolver.  Trust anchors are DS or DNSKEY records in zone
+// file format, e.g. ". IN DS 20326 8 2 E06D44B8...".
+func NewDNSSECValidator(resolver *DNSResolver, trustAnchors []string) (*DNSSECValidator, error) {
+	v := &DNSSECValidator{
+		resolver: resolver,
+		anchors:  map[string][]*dns.DS{},
+		clk:      time.Now,
+	}
+	for _, anchor := range trustAnchors {
+		rr, err := dns.NewRR(anchor)
+		if err != nil {
+			return nil, fmt.Errorf("Bad DNSSEC trust anchor %q: %s", anchor, err)
+		}
+		var ds *dns.DS
+		switch rr := rr.(type) {
+		case *dns.DS:
+			ds = rr
+		case *dns.DNSKEY:
+			ds = rr.ToDS(dns.SHA256)
+		}
+		if ds == nil {
+			return nil, fmt.Errorf("DNSSEC trust anchor %q is not a DS or DNSKEY record", anchor)
+		}
+		zone := canonicalName(ds.Hdr.Name)
+		v.anchors[zone] = append(v.anchors[zone], ds)
+	}
+	if len(v.anchors) == 0 {
+		return nil, fmt.Errorf("No DNSSEC trust anchors configured")
+	}
+	return v, nil
+}
+
+func canonicalName(name string) string {
+	return strings.ToLower(dns.Fqdn(name))
+}
+
+// isAncestorOrSelf returns whether child is at or below parent.  Both names
+// must be canonical.
+func isAncestorOrSelf(parent, child string) bool {
+	return parent == "." || parent == child || strings.HasSuffix(child, "."+parent)
+}
+
+func parentName(name string) string {
+	if name == "." {
+		return "."
+	}
+	labels := dns.SplitDomainName(name)
+	if len(labels) <= 1 {
+		return "."
+	}
+	return strings.Join(labels[1:], ".") + "."
+}
+
+// fetch queries for records with checking disabled, since the validator does
+// its own checking.
+func (v *DNSSECValidator) fetch(name string, qtype uint16) (*dns.Msg, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.SetEdns0(4096, true)
+	m.CheckingDisabled = true
+	r, _, err := v.resolver.Exchange(m)
+	if err != nil {
+		return nil, err
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return nil, fmt.Errorf("Invalid response code: %d-%s", r.Rcode, dns.RcodeToString[r.Rcode])
+	}
+	return r, nil
+}
+
+type rrsetKey struct {
+	name   string
+	rrtype uint16
+}
+
+// splitRRsets groups records into RRsets, and RRSIGs by the RRset they cover
+func splitRRsets(rrs []dns.RR) (map[rrsetKey][]dns.RR, map[rrsetKey][]*dns.RRSIG) {
+	sets := map[rrsetKey][]dns.RR{}
+	sigs := map[rrsetKey][]*dns.RRSIG{}
+	for _, rr := range rrs {
+		hdr := rr.Header()
+		if sig, ok := rr.(*dns.RRSIG); ok {
+			key := rrsetKey{canonicalName(hdr.Name), sig.TypeCovered}
+			sigs[key] = append(sigs[key], sig)
+			continue
+		}
+		if hdr.Rrtype == dns.TypeOPT {
+			continue
+		}
+		key := rrsetKey{canonicalName(hdr.Name), hdr.Rrtype}
+		sets[key] = append(sets[key], rr)
+	}
+	return sets, sigs
+}
+
+// verifyRRset returns the first currently valid signature over the RRset made
+// by one of the keys, or nil if there is none.
+func (v *DNSSECValidator) verifyRRset(rrset []dns.RR, sigs []*dns.RRSIG, keys []*dns.DNSKEY) *dns.RRSIG {
+	now := v.clk()
+	for _, sig := range sigs {
+		if !sig.ValidityPeriod(now) {
+			continue
+		}
+		for _, key := range keys {
+			// Only zone keys may sign zone data (RFC 4034 Section 2.1.1)
+			if key.Flags&dns.ZONE == 0 || key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm {
+				continue
+			}
+			if sig.Verify(key, rrset) == nil {
+				return sig
+			}
+		}
+	}
+	return nil
+}
+
+// signerOf returns the common signer of the signatures, or "" if they don't
+// have one
+func signerOf(sigs []*dns.RRSIG) string {
+	if len(sigs) == 0 {
+		return ""
+	}
+	signer := canonicalName(sigs[0].SignerName)
+	for _, sig := range sigs[1:] {
+		if canonicalName(sig.SignerName) != signer {
+			return ""
+		}
+	}
+	return signer
+}
+
+// anchored returns whether a trust anchor is configured at or above the zone
+func (v *DNSSECValidator) anchored(zone string) bool {
+	for anchor := range v.anchors {
+		if isAncestorOrSelf(anchor, zone) {
+			return true
+		}
+	}
+	return false
+}
+
+// enclosingZone finds the apex of the zone containing the name, from the SOA
+// record returned for it.
+func (v *DNSSECValidator) enclosingZone(name string) (string, error) {
+	for {
+		r, err := v.fetch(name, dns.TypeSOA)
+		if err != nil {
+			return "", err
+		}
+		for _, rr := range append(r.Answer, r.Ns...) {
+			if rr.Header().Rrtype != dns.TypeSOA {
+				continue
+			}
+			owner := canonicalName(rr.Header().Name)
+			if isAncestorOrSelf(owner, name) {
+				return owner, nil
+			}
+		}
+		if name == "." {
+			return "", fmt.Errorf("No SOA record found for the root zone")
+		}
+		name = parentName(name)
+	}
+}
+
+// zoneKeys returns the validated DNSKEY RRset of a zone, following the chain
+// of DS records up to a trust anchor.  If the zone is below an insecure
+// delegation or isn't covered by any anchor, the status is DNSSECInsecure.
+func (v *DNSSECValidator) zoneKeys(zone string, depth int) ([]*dns.DNSKEY, DNSSECStatus, error) {
+	if !v.anchored(zone) {
+		return nil, DNSSECInsecure, nil
+	}
+	if depth > maxDNSSECChainLength {
+		return nil, DNSSECBogus, fmt.Errorf("DNSSEC chain of trust for %s is too long", zone)
+	}
+
+	dsSet, present := v.anchors[zone]
+	if !present {
+		var status DNSSECStatus
+		var err error
+		dsSet, status, err = v.delegation(zone, depth)
+		if status != DNSSECSecure || err != nil {
+			return nil, status, err
+		}
+	}
+
+	// A zone whose DS records all use algorithms we can't check is treated
+	// as insecure (RFC 4035 Section 5.2)
+	usable := false
+	for _, ds := range dsSet {
+		if supportedDNSSECAlgorithms[ds.Algorithm] && supportedDSDigests[ds.DigestType] {
+			usable = true
+		}
+	}
+	if !usable {
+		return nil, DNSSECInsecure, nil
+	}
+
+	r, err := v.fetch(zone, dns.TypeDNSKEY)
+	if err != nil {
+		return nil, DNSSECBogus, err
+	}
+	sets, sigs := splitRRsets(r.Answer)
+	key := rrsetKey{zone, dns.TypeDNSKEY}
+	var keys []*dns.DNSKEY
+	for _, rr := range sets[key] {
+		keys = append(keys, rr.(*dns.DNSKEY))
+	}
+
+	// The DNSKEY RRset must be signed by a key matching one of the DS records
+	for _, ds := range dsSet {
+		if !supportedDNSSECAlgorithms[ds.Algorithm] || !supportedDSDigests[ds.DigestType] {
+			continue
+		}
+		for _, k := range keys {
+			if k.KeyTag() != ds.KeyTag || k.Algorithm != ds.Algorithm {
+				continue
+			}
+			digest := k.ToDS(ds.DigestType)
+			if digest == nil || !strings.EqualFold(digest.Digest, ds.Digest) {
+				continue
+			}
+			if v.verifyRRset(sets[key], sigs[key], []*dns.DNSKEY{k}) != nil {
+				return keys, DNSSECSecure, nil
+			}
+		}
+	}
+	return nil, DNSSECBogus, fmt.Errorf("No valid DNSKEY for %s matches its DS records", zone)
+}
+
+// delegation validates the DS RRset for a zone in its parent.  If the parent
+// proves there are no DS records the status is DNSSECInsecure.
+func (v *DNSSECValidator) delegation(zone string, depth int) ([]*dns.DS, DNSSECStatus, error) {
+	r, err := v.fetch(zone, dns.TypeDS)
+	if err != nil {
+		return nil, DNSSECBogus, err
+	}
+
+	sets, sigs := splitRRsets(r.Answer)
+	key := rrsetKey{zone, dns.TypeDS}
+	if dsRRs := sets[key]; len(dsRRs) > 0 {
+		parent := signerOf(sigs[key])
+		if parent == "" {
+			// Unsigned DS records are only acceptable from an insecure parent
+			parent, err = v.enclosingZone(parentName(zone))
+			if err != nil {
+				return nil, DNSSECBogus, err
+			}
+		}
+		if parent == zone || !isAncestorOrSelf(parent, zone) {
+			return nil, DNSSECBogus, fmt.Errorf("DS records for %s signed by %s", zone, parent)
+		}
+		keys, status, err := v.zoneKeys(parent, depth+1)
+		if status != DNSSECSecure || err != nil {
+			return nil, status, err
+		}
+		if v.verifyRRset(dsRRs, sigs[key], keys) == nil {
+			return nil, DNSSECBogus, fmt.Errorf("No valid signature on DS records for %s", zone)
+		}
+		var dsSet []*dns.DS
+		for _, rr := range dsRRs {
+			dsSet = append(dsSet, rr.(*dns.DS))
+		}
+		return dsSet, DNSSECSecure, nil
+	}
+
+	// No DS records: the parent must prove the delegation is unsigned
+	parent := ""
+	for _, rr := range r.Ns {
+		if rr.Header().Rrtype == dns.TypeSOA {
+			parent = canonicalName(rr.Header().Name)
+		}
+	}
+	if parent == "" || parent == zone || !isAncestorOrSelf(parent, zone) {
+		return nil, DNSSECBogus, fmt.Errorf("No proof of an unsigned delegation for %s", zone)
+	}
+	keys, status, err := v.zoneKeys(parent, depth+1)
+	if status != DNSSECSecure || err != nil {
+		return nil, status, err
+	}
+	proof, err := v.denial(zone, dns.TypeDS, r, keys)
+	if err != nil {
+		return nil, DNSSECBogus, err
+	}
+	// A denial too costly to check is treated as insecure, as it is for
+	// answers (RFC 9276, Section 3.2)
+	if proof != denialNoData && proof != denialOptOut && proof != denialTooManyIterations {
+		return nil, DNSSECBogus, fmt.Errorf("No proof of an unsigned delegation for %s", zone)
+	}
+	return nil, DNSSECInsecure, nil
+}
+
+// Validate checks a response to a query for qname and qtype, returning
+// DNSSECBogus and an error if it fails validation.
+func (v *DNSSECValidator) Validate(qname string, qtype uint16, r *dns.Msg) (DNSSECStatus, error) {
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return DNSSECUnchecked, nil
+	}
+	qname = canonicalName(qname)
+
+	sets, sigs := splitRRsets(r.Answer)
+	if len(sets) > 0 && r.Rcode == dns.RcodeSuccess {
+		return v.validateAnswer(r, sets, sigs)
+	}
+	return v.validateDenial(qname, qtype, r)
+}
+
+// validateAnswer checks each RRset in the answer section, including any
+// CNAMEs followed, against the keys of its signer.
+func (v *DNSSECValidator) validateAnswer(r *dns.Msg, sets map[rrsetKey][]dns.RR, sigs map[rrsetKey][]*dns.RRSIG) (DNSSECStatus, error) {
+	result := DNSSECSecure
+	for key, rrset := range sets {
+		signer := signerOf(sigs[key])
+		if signer == "" {
+			// An unsigned RRset is only acceptable in an insecure zone
+			zone, err := v.enclosingZone(key.name)
+			if err != nil {
+				return DNSSECBogus, err
+			}
+			_, status, err := v.zoneKeys(zone, 0)
+			if status == DNSSECSecure {
+				return DNSSECBogus, fmt.Errorf("Missing signature on %s %s", key.name, dns.TypeToString[key.rrtype])
+			}
+			if status == DNSSECBogus {
+				return DNSSECBogus, err
+			}
+			result = DNSSECInsecure
+			continue
+		}
+		if !isAncestorOrSelf(signer, key.name) {
+			return DNSSECBogus, fmt.Errorf("%s %s signed by unrelated zone %s", key.name, dns.TypeToString[key.rrtype], signer)
+		}
+
+		keys, status, err := v.zoneKeys(signer, 0)
+		if status == DNSSECBogus {
+			return DNSSECBogus, err
+		}
+		if status == DNSSECInsecure {
+			result = DNSSECInsecure
+			continue
+		}
+		sig := v.verifyRRset(rrset, sigs[key], keys)
+		if sig == nil {
+			return DNSSECBogus, fmt.Errorf("No valid signature on %s %s", key.name, dns.TypeToString[key.rrtype])
+		}
+
+		// An answer synthesized from a wildcard needs a proof that the name
+		// itself doesn't exist (RFC 4035 Section 5.3.4)
+		if int(sig.Labels) < dns.CountLabel(key.name) {
+			if !v.provesWildcardExpansion(key.name, int(sig.Labels), r.Ns, keys) {
+				return DNSSECBogus, fmt.Errorf("No proof for wildcard expansion of %s", key.name)
+			}
+		}
+	}
+	return result, nil
+}
+
+// validateDenial checks the signatures on an NXDOMAIN or empty response and
+// its NSEC or NSEC3 proof.
+func (v *DNSSECValidator) validateDenial(qname string, qtype uint16, r *dns.Msg) (DNSSECStatus, error) {
+	zone := ""
+	for _, rr := range r.Ns {
+		if rr.Header().Rrtype == dns.TypeSOA {
+			zone = canonicalName(rr.Header().Name)
+		}
+	}
+	if zone == "" || !isAncestorOrSelf(zone, qname) {
+		var err error
+		zone, err = v.enclosingZone(qname)
+		if err != nil {
+			return DNSSECBogus, err
+		}
+	}
+
+	keys, status, err := v.zoneKeys(zone, 0)
+	if status != DNSSECSecure || err != nil {
+		return status, err
+	}
+	proof, err := v.denial(qname, qtype, r, keys)
+	if err != nil {
+		return DNSSECBogus, err
+	}
+	if r.Rcode == dns.RcodeNameError && proof == denialNoData {
+		return DNSSECBogus, fmt.Errorf("NXDOMAIN response for %s proves the name exists", qname)
+	}
+	if proof == denialOptOut || proof == denialTooManyIterations {
+		return DNSSECInsecure, nil
+	}
+	return DNSSECSecure, nil
+}
+
+type denialProof int
+
+const (
+	denialNone denialProof = iota
+	denialNoData
+	denialNXDomain
+	denialOptOut // the name may be an unsigned delegation (RFC 5155 Section 6)
+
+	// the NSEC3 records use more than maxNSEC3Iterations
+	denialTooManyIterations
+)
+
+// denial verifies the NSEC or NSEC3 records in the authority section with the
+// zone's keys, and returns what they prove about qname and qtype.
+func (v *DNSSECValidator) denial(qname string, qtype uint16, r *dns.Msg, keys []*dns.DNSKEY) (denialProof, error) {
+	nsecs, nsec3s, err := v.verifiedDenialRecords(r.Ns, keys)
+	if err != nil {
+		return denialNone, err
+	}
+	var proof denialProof
+	if len(nsec3s) > 0 {
+		proof = nsec3Denial(qname, qtype, nsec3s)
+	} else {
+		proof = nsecDenial(qname, qtype, nsecs)
+	}
+	if proof == denialNone {
+		return denialNone, fmt.Errorf("No proof of non-existence for %s %s", qname, dns.TypeToString[qtype])
+	}
+	return proof, nil
+}
+
+// verifiedDenialRecords returns the NSEC and NSEC3 records in the section,
+// which must all be validly signed by the keys.
+func (v *DNSSECValidator) verifiedDenialRecords(section []dns.RR, keys []*dns.DNSKEY) ([]*dns.NSEC, []*dns.NSEC3, error) {
+	var nsecs []*dns.NSEC
+	var nsec3s []*dns.NSEC3
+	sets, sigs := splitRRsets(section)
+	for key, rrset := range sets {
+		if key.rrtype != dns.TypeNSEC && key.rrtype != dns.TypeNSEC3 && key.rrtype != dns.TypeSOA {
+			continue
+		}
+		if v.verifyRRset(rrset, sigs[key], keys) == nil {
+			return nil, nil, fmt.Errorf("No valid signature on %s %s", key.name, dns.TypeToString[key.rrtype])
+		}
+		for _, rr := range rrset {
+			switch rr := rr.(type) {
+			case *dns.NSEC:
+				nsecs = append(nsecs, rr)
+			case *dns.NSEC3:
+				nsec3s = append(nsec3s, rr)
+			}
+		}
+	}
+	return nsecs, nsec3s, nil
+}
+
+// provesWildcardExpansion checks that the authority section proves that the
+// name, answered from a wildcard with the given number of labels, doesn't
+// exist.
+func (v *DNSSECValidator) provesWildcardExpansion(name string, labels int, section []dns.RR, keys []*dns.DNSKEY) bool {
+	nsecs, nsec3s, err := v.verifiedDenialRecords(section, keys)
+	if err != nil {
+		return false
+	}
+	for _, nsec := range nsecs {
+		if nsecCovers(nsec, name) {
+			return true
+		}
+	}
+	// With NSEC3 the next closer name to the wildcard's closest encloser
+	// must be covered (RFC 5155 Section 8.8)
+	nameLabels := dns.SplitDomainName(name)
+	if len(nameLabels) <= labels {
+		return false
+	}
+	nextCloser := strings.Join(nameLabels[len(nameLabels)-labels-1:], ".") + "."
+	for _, nsec3 := range nsec3s {
+		if nsec3Covers(nsec3, nextCloser) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasType(bitmap []uint16, rrtype uint16) bool {
+	for _, t := range bitmap {
+		if t == rrtype {
+			return true
+		}
+	}
+	return false
+}
+
+// canonicalCompare orders names as in RFC 4034 Section 6.1, comparing labels
+// from the right.  The names must be canonical.
+func canonicalCompare(a, b string) int {
+	la := dns.SplitDomainName(a)
+	lb := dns.SplitDomainName(b)
+	for i, j := len(la)-1, len(lb)-1; i >= 0 && j >= 0; i, j = i-1, j-1 {
+		if la[i] < lb[j] {
+			return -1
+		} else if la[i] > lb[j] {
+			return 1
+		}
+	}
+	return len(la) - len(lb)
+}
+
+// nsecCovers returns whether the name falls strictly between the NSEC's owner
+// and next name.  The last NSEC in a zone wraps around to the apex.
+func nsecCovers(nsec *dns.NSEC, name string) bool {
+	owner := canonicalName(nsec.Hdr.Name)
+	next := canonicalName(nsec.NextDomain)
+	if canonicalCompare(owner, next) >= 0 {
+		return canonicalCompare(name, owner) > 0 && isAncestorOrSelf(next, name)
+	}
+	return canonicalCompare(owner, name) < 0 && canonicalCompare(name, next) < 0
+}
+
+// closestEncloserNSEC finds the closest encloser of a name covered by an NSEC:
+// its longest ancestor that the NSEC shows to exist.
+func closestEncloserNSEC(name string, nsec *dns.NSEC) string {
+	owner := canonicalName(nsec.Hdr.Name)
+	next := canonicalName(nsec.NextDomain)
+	common := dns.CompareDomainName(name, owner)
+	if n := dns.CompareDomainName(name, next); n > common {
+		common = n
+	}
+	labels := dns.SplitDomainName(name)
+	if common == 0 {
+		return "."
+	}
+	return strings.Join(labels[len(labels)-common:], ".") + "."
+}
+
+func nsecDenial(qname string, qtype uint16, nsecs []*dns.NSEC) denialProof {
+	for _, nsec := range nsecs {
+		if canonicalName(nsec.Hdr.Name) != qname {
+			continue
+		}
+		if hasType(nsec.TypeBitMap, qtype) || hasType(nsec.TypeBitMap, dns.TypeCNAME) {
+			return denialNone
+		}
+		// DS records are denied by the parent, not the child's apex
+		if qtype == dns.TypeDS && hasType(nsec.TypeBitMap, dns.TypeSOA) {
+			return denialNone
+		}
+		return denialNoData
+	}
+
+	for _, nsec := range nsecs {
+		if !nsecCovers(nsec, qname) {
+			continue
+		}
+		// The name doesn't exist; neither may a wildcard that would match
+		// it, or the wildcard must exist without the type
+		wildcard := "*." + strings.TrimPrefix(closestEncloserNSEC(qname, nsec), ".")
+		for _, w := range nsecs {
+			if nsecCovers(w, wildcard) {
+				return denialNXDomain
+			}
+			if canonicalName(w.Hdr.Name) == wildcard && !hasType(w.TypeBitMap, qtype) && !hasType(w.TypeBitMap, dns.TypeCNAME) {
+				return denialNoData
+			}
+		}
+	}
+	return denialNone
+}
+
+// nsec3Hash returns the NSEC3 hash of the name, or "" if the record asks for
+// more than maxNSEC3Iterations.
+func nsec3Hash(nsec3 *dns.NSEC3, name string) string {
+	if nsec3.Iterations > maxNSEC3Iterations {
+		return ""
+	}
+	return dns.HashName(name, nsec3.Hash, nsec3.Iterations, nsec3.Salt)
+}
+
+func nsec3OwnerHash(nsec3 *dns.NSEC3) string {
+	return strings.ToUpper(dns.SplitDomainName(nsec3.Hdr.Name)[0])
+}
+
+func nsec3Matches(nsec3 *dns.NSEC3, name string) bool {
+	hash := nsec3Hash(nsec3, name)
+	return hash != "" && hash == nsec3OwnerHash(nsec3)
+}
+
+// nsec3Covers returns whether the name's hash falls strictly between the
+// NSEC3's owner and next hashes.  Base32hex preserves the order of the
+// hashes, so the encoded forms can be compared directly.
+func nsec3Covers(nsec3 *dns.NSEC3, name string) bool {
+	hash := nsec3Hash(nsec3, name)
+	if hash == "" {
+		return false
+	}
+	owner := nsec3OwnerHash(nsec3)
+	next := strings.ToUpper(nsec3.NextDomain)
+	if owner >= next {
+		return hash > owner || hash < next
+	}
+	return owner < hash && hash < next
+}
+
+// nsec3ClosestEncloser finds the closest provable encloser of the name and
+// the next closer name below it (RFC 5155 Section 8.3).
+func nsec3ClosestEncloser(name string, nsec3s []*dns.NSEC3) (encloser, nextCloser string) {
+	for candidate, child := name, ""; ; candidate, child = parentName(candidate), candidate {
+		for _, nsec3 := range nsec3s {
+			if nsec3Matches(nsec3, candidate) {
+				return candidate, child
+			}
+		}
+		if candidate == "." {
+			return "", ""
+		}
+	}
+}
+
+func nsec3Denial(qname string, qtype uint16, nsec3s []*dns.NSEC3) denialProof {
+	for _, nsec3 := range nsec3s {
+		if nsec3.Iterations > maxNSEC3Iterations {
+			return denialTooManyIterations
+		}
+	}
+	for _, nsec3 := range nsec3s {
+		if !nsec3Matches(nsec3, qname) {
+			continue
+		}
+		if hasType(nsec3.TypeBitMap, qtype) || hasType(nsec3.TypeBitMap, dns.TypeCNAME) {
+			return denialNone
+		}
+		if qtype == dns.TypeDS && hasType(nsec3.TypeBitMap, dns.TypeSOA) {
+			return denialNone
+		}
+		return denialNoData
+	}
+
+	encloser, nextCloser := nsec3ClosestEncloser(qname, nsec3s)
+	if encloser == "" || nextCloser == "" {
+		return denialNone
+	}
+	var covering *dns.NSEC3
+	for _, nsec3 := range nsec3s {
+		if nsec3Covers(nsec3, nextCloser) {
+			covering = nsec3
+		}
+	}
+	if covering == nil {
+		return denialNone
+	}
+	if covering.Flags&1 == 1 {
+		// Opt-out spans may hide unsigned delegations, so nothing is proven
+		// about names below them
+		return denialOptOut
+	}
+
+	wildcard := "*." + strings.TrimPrefix(encloser, ".")
+	for _, nsec3 := range nsec3s {
+		if nsec3Covers(nsec3, wildcard) {
+			return denialNXDomain
+		}
+		if nsec3Matches(nsec3, wildcard) && !hasType(nsec3.TypeBitMap, qtype) && !hasType(nsec3.TypeBitMap, dns.TypeCNAME) {
+			return denialNoData
+		}
+	}
+	return denialNone
+}
@@ -0,0 +1,515 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package core
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/test"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
+)
+
+// signedZone is a test zone, signed with a single key if key is set, with an
+// NSEC or NSEC3 chain.
+type signedZone struct {
+	apex       string
+	key        *dns.DNSKEY
+	priv       dns.PrivateKey
+	nsec3      bool
+	iterations uint16
+	rrsets     map[rrsetKey][]dns.RR
+	sigs       map[rrsetKey][]dns.RR
+	// denial records by owner name, and the NSEC3 records in hash order
+	denials map[string]dns.RR
+	chain   []*dns.NSEC3
+}
+
+func testRR(t *testing.T, s string) dns.RR {
+	rr, err := dns.NewRR(s)
+	test.AssertNotError(t, err, "Bad test record "+s)
+	return rr
+}
+
+func newSignedZone(t *testing.T, apex string, signed, nsec3 bool) *signedZone {
+	z := &signedZone{
+		apex:    apex,
+		nsec3:   nsec3,
+		rrsets:  map[rrsetKey][]dns.RR{},
+		sigs:    map[rrsetKey][]dns.RR{},
+		denials: map[string]dns.RR{},
+	}
+	z.add(testRR(t, apex+" 300 IN SOA ns."+apex+" hostmaster."+apex+" 1 3600 600 86400 300"))
+	if signed {
+		z.key = &dns.DNSKEY{
+			Hdr:       dns.RR_Header{Name: apex, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 300},
+			Flags:     dns.ZONE | dns.SEP,
+			Protocol:  3,
+			Algorithm: dns.ECDSAP256SHA256,
+		}
+		var err error
+		z.priv, err = z.key.Generate(256)
+		test.AssertNotError(t, err, "Failed to generate zone key")
+		z.add(z.key)
+	}
+	return z
+}
+
+func (z *signedZone) add(rrs ...dns.RR) {
+	for _, rr := range rrs {
+		key := rrsetKey{canonicalName(rr.Header().Name), rr.Header().Rrtype}
+		z.rrsets[key] = append(z.rrsets[key], rr)
+	}
+}
+
+// delegate adds a delegation to the child zone, with a DS record if it's
+// signed.
+func (z *signedZone) delegate(t *testing.T, child *signedZone) {
+	z.add(testRR(t, child.apex+" 300 IN NS ns."+child.apex))
+	if child.key != nil {
+		z.add(child.key.ToDS(dns.SHA256))
+	}
+}
+
+func (z *signedZone) sign(t *testing.T, rrset []dns.RR) dns.RR {
+	sig := &dns.RRSIG{
+		Algorithm:  z.key.Algorithm,
+		Inception:  uint32(time.Now().Add(-time.Hour).Unix()),
+		Expiration: uint32(time.Now().Add(time.Hour).Unix()),
+		KeyTag:     z.key.KeyTag(),
+		SignerName: z.apex,
+	}
+	test.AssertNotError(t, sig.Sign(z.priv, rrset), "Failed to sign RRset")
+	return sig
+}
+
+// finish builds the denial chain and signs the zone
+func (z *signedZone) finish(t *testing.T) {
+	if z.key == nil {
+		return
+	}
+	types := map[string][]uint16{}
+	for key := range z.rrsets {
+		types[key.name] = append(types[key.name], key.rrtype)
+	}
+	var names []string
+	for name := range types {
+		names = append(names, name)
+	}
+
+	if z.nsec3 {
+		// Empty non-terminals get NSEC3 records too (RFC 5155 Section 7.1)
+		for _, name := range names {
+			for n := parentName(name); n != z.apex && isAncestorOrSelf(z.apex, n); n = parentName(n) {
+				if _, present := types[n]; !present {
+					types[n] = nil
+					names = append(names, n)
+				}
+			}
+		}
+		hashed := map[string]string{}
+		var hashes []string
+		for _, name := range names {
+			h := dns.HashName(name, dns.SHA1, z.iterations, "")
+			hashed[h] = name
+			hashes = append(hashes, h)
+		}
+		sort.Strings(hashes)
+		for i, h := range hashes {
+			var bitmap []uint16
+			if len(types[hashed[h]]) > 0 {
+				bitmap = append(types[hashed[h]], dns.TypeRRSIG)
+				sort.Sort(uint16s(bitmap))
+			}
+			nsec3 := &dns.NSEC3{
+				Hdr:        dns.RR_Header{Name: strings.ToLower(h) + "." + z.apex, Rrtype: dns.TypeNSEC3, Class: dns.ClassINET, Ttl: 300},
+				Hash:       dns.SHA1,
+				Iterations: z.iterations,
+				HashLength: 20,
+				NextDomain: hashes[(i+1)%len(hashes)],
+				TypeBitMap: bitmap,
+			}
+			z.denials[hashed[h]] = nsec3
+			z.chain = append(z.chain, nsec3)
+		}
+	} else {
+		sort.Sort(canonicalNames(names))
+		for i, name := range names {
+			bitmap := append(types[name], dns.TypeRRSIG, dns.TypeNSEC)
+			sort.Sort(uint16s(bitmap))
+			z.denials[name] = &dns.NSEC{
+				Hdr:        dns.RR_Header{Name: name, Rrtype: dns.TypeNSEC, Class: dns.ClassINET, Ttl: 300},
+				NextDomain: names[(i+1)%len(names)],
+				TypeBitMap: bitmap,
+			}
+		}
+	}
+
+	for key, rrset := range z.rrsets {
+		// Delegation NS records aren't signed by the parent
+		if key.rrtype == dns.TypeNS && key.name != z.apex {
+			continue
+		}
+		z.sigs[key] = []dns.RR{z.sign(t, rrset)}
+	}
+	for _, rr := range z.denials {
+		key := rrsetKey{canonicalName(rr.Header().Name), rr.Header().Rrtype}
+		z.sigs[key] = []dns.RR{z.sign(t, []dns.RR{rr})}
+	}
+}
+
+func (z *signedZone) withSigs(rrs ...dns.RR) []dns.RR {
+	var out []dns.RR
+	for _, rr := range rrs {
+		out = append(out, rr)
+		out = append(out, z.sigs[rrsetKey{canonicalName(rr.Header().Name), rr.Header().Rrtype}]...)
+	}
+	return out
+}
+
+func (z *signedZone) soa() []dns.RR {
+	return z.withSigs(z.rrsets[rrsetKey{z.apex, dns.TypeSOA}]...)
+}
+
+// exists returns whether the name has records, or is an empty non-terminal
+func (z *signedZone) exists(name string) bool {
+	for key := range z.rrsets {
+		if isAncestorOrSelf(name, key.name) {
+			return true
+		}
+	}
+	return false
+}
+
+// coveringNSEC3 returns the NSEC3 record whose span covers the name's hash
+func (z *signedZone) coveringNSEC3(name string) dns.RR {
+	for _, nsec3 := range z.chain {
+		if nsec3Covers(nsec3, name) {
+			return nsec3
+		}
+	}
+	return nil
+}
+
+// answer responds to a query as a recursive resolver would, from the zone
+func (z *signedZone) answer(m *dns.Msg, qname string, qtype uint16) {
+	if rrset := z.rrsets[rrsetKey{qname, qtype}]; len(rrset) > 0 {
+		m.Answer = z.withSigs(rrset...)
+		return
+	}
+	if z.exists(qname) {
+		m.Ns = z.soa()
+		if z.denials[qname] != nil {
+			m.Ns = append(m.Ns, z.withSigs(z.denials[qname])...)
+		}
+		return
+	}
+
+	labels := dns.SplitDomainName(qname)
+	encloser := z.apex
+	for i := range labels {
+		candidate := strings.Join(labels[i:], ".") + "."
+		if z.exists(candidate) && isAncestorOrSelf(z.apex, candidate) {
+			encloser = candidate
+			break
+		}
+	}
+	wildcard := "*." + encloser
+	if rrset := z.rrsets[rrsetKey{wildcard, qtype}]; len(rrset) > 0 {
+		// Synthesize the answer from the wildcard, with a proof the name
+		// itself doesn't exist
+		for _, rr := range z.withSigs(rrset...) {
+			rr = dns.Copy(rr)
+			rr.Header().Name = qname
+			m.Answer = append(m.Answer, rr)
+		}
+		if z.nsec3 {
+			nextCloser := strings.Join(labels[len(labels)-dns.CountLabel(encloser)-1:], ".") + "."
+			m.Ns = z.withSigs(z.coveringNSEC3(nextCloser))
+		} else {
+			m.Ns = z.withSigs(z.coveringNSEC(qname))
+		}
+		return
+	}
+
+	m.Rcode = dns.RcodeNameError
+	m.Ns = z.soa()
+	if z.key == nil {
+		return
+	}
+	if z.nsec3 {
+		nextCloser := strings.Join(labels[len(labels)-dns.CountLabel(encloser)-1:], ".") + "."
+		m.Ns = append(m.Ns, z.withSigs(z.denials[encloser], z.coveringNSEC3(nextCloser), z.coveringNSEC3(wildcard))...)
+	} else {
+		m.Ns = append(m.Ns, z.withSigs(z.coveringNSEC(qname), z.coveringNSEC(wildcard))...)
+	}
+}
+
+func (z *signedZone) coveringNSEC(name string) dns.RR {
+	for _, rr := range z.denials {
+		if nsecCovers(rr.(*dns.NSEC), name) {
+			return rr
+		}
+	}
+	return nil
+}
+
+type uint16s []uint16
+
+func (s uint16s) Len() int           { return len(s) }
+func (s uint16s) Less(i, j int) bool { return s[i] < s[j] }
+func (s uint16s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type canonicalNames []string
+
+func (s canonicalNames) Len() int           { return len(s) }
+func (s canonicalNames) Less(i, j int) bool { return canonicalCompare(s[i], s[j]) < 0 }
+func (s canonicalNames) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// dnssecTestZones builds a signed parent zone "test." with delegations to
+// signed, unsigned, NSEC3-signed and misconfigured child zones.  It returns
+// the zones from the most specific, and the trust anchor for "test.".
+func dnssecTestZones(t *testing.T) ([]*signedZone, string) {
+	parent := newSignedZone(t, "test.", true, false)
+	secure := newSignedZone(t, "secure.test.", true, false)
+	insecure := newSignedZone(t, "insecure.test.", false, false)
+	nsec3 := newSignedZone(t, "nsec3.test.", true, true)
+	bogus := newSignedZone(t, "bogus.test.", true, false)
+
+	secure.add(
+		testRR(t, `_acme-challenge.secure.test. 300 IN TXT "token"`),
+		testRR(t, `www.secure.test. 300 IN A 192.0.2.1`),
+		testRR(t, `tampered.secure.test. 300 IN TXT "token"`),
+		testRR(t, `stripped.secure.test. 300 IN TXT "token"`),
+		testRR(t, `*.wild.secure.test. 300 IN TXT "token"`),
+	)
+	insecure.add(testRR(t, `_acme-challenge.insecure.test. 300 IN TXT "token"`))
+	nsec3.add(
+		testRR(t, `_acme-challenge.nsec3.test. 300 IN TXT "token"`),
+		testRR(t, `*.wild.nsec3.test. 300 IN TXT "token"`),
+	)
+	bogus.add(testRR(t, `_acme-challenge.bogus.test. 300 IN TXT "token"`))
+
+	parent.delegate(t, secure)
+	parent.delegate(t, insecure)
+	parent.delegate(t, nsec3)
+	// The DS record in the parent doesn't match the child's key
+	other := newSignedZone(t, "bogus.test.", true, false)
+	parent.delegate(t, other)
+
+	for _, z := range []*signedZone{parent, secure, insecure, nsec3, bogus} {
+		z.finish(t)
+	}
+
+	// Break the signature on one record, and strip it from another
+	tampered := rrsetKey{"tampered.secure.test.", dns.TypeTXT}
+	secure.rrsets[tampered] = []dns.RR{testRR(t, `tampered.secure.test. 300 IN TXT "forged"`)}
+	delete(secure.sigs, rrsetKey{"stripped.secure.test.", dns.TypeTXT})
+
+	return []*signedZone{secure, insecure, nsec3, bogus, parent}, parent.key.ToDS(dns.SHA256).String()
+}
+
+func dnssecHandler(zones []*signedZone) func(w dns.ResponseWriter, r *dns.Msg) {
+	return func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(r)
+		q := r.Question[0]
+		qname := canonicalName(q.Name)
+		for _, z := range zones {
+			// DS records are served from the parent side of a zone cut
+			if !isAncestorOrSelf(z.apex, qname) || (q.Qtype == dns.TypeDS && qname == z.apex) {
+				continue
+			}
+			z.answer(m, qname, q.Qtype)
+			break
+		}
+		if m.Answer == nil && m.Ns == nil && m.Rcode == dns.RcodeSuccess {
+			m.Rcode = dns.RcodeRefused
+		}
+		w.WriteMsg(m)
+	}
+}
+
+func TestDNSSECValidation(t *testing.T) {
+	zones, anchor := dnssecTestZones(t)
+	server := startTestDNSServer(t, dnssecHandler(zones))
+	defer server.stop()
+
+	resolver := NewDNSResolver(time.Second, []string{server.addr})
+	var err error
+	resolver.Validator, err = NewDNSSECValidator(resolver, []string{anchor})
+	test.AssertNotError(t, err, "Failed to create validator")
+
+	tests := []struct {
+		name   string
+		qtype  uint16
+		status DNSSECStatus
+	}{
+		// Signed answers
+		{"_acme-challenge.secure.test", dns.TypeTXT, DNSSECSecure},
+		{"_acme-challenge.nsec3.test", dns.TypeTXT, DNSSECSecure},
+		{"secure.test", dns.TypeDNSKEY, DNSSECSecure},
+		// Answers synthesized from wildcards
+		{"foo.wild.secure.test", dns.TypeTXT, DNSSECSecure},
+		{"foo.wild.nsec3.test", dns.TypeTXT, DNSSECSecure},
+		// Proven non-existent names and types
+		{"_acme-challenge.www.secure.test", dns.TypeTXT, DNSSECSecure},
+		{"www.secure.test", dns.TypeTXT, DNSSECSecure},
+		{"missing.nsec3.test", dns.TypeTXT, DNSSECSecure},
+		{"_acme-challenge.nsec3.test", dns.TypeMX, DNSSECSecure},
+		// Below an unsigned delegation
+		{"_acme-challenge.insecure.test", dns.TypeTXT, DNSSECInsecure},
+		{"missing.insecure.test", dns.TypeTXT, DNSSECInsecure},
+		// Broken or missing signatures and keys
+		{"tampered.secure.test", dns.TypeTXT, DNSSECBogus},
+		{"stripped.secure.test", dns.TypeTXT, DNSSECBogus},
+		{"_acme-challenge.bogus.test", dns.TypeTXT, DNSSECBogus},
+	}
+	for _, tc := range tests {
+		m := new(dns.Msg)
+		m.SetQuestion(dns.Fqdn(tc.name), tc.qtype)
+		_, status, _, err := resolver.LookupDNSSECStatus(m)
+		test.AssertEquals(t, status, tc.status)
+		if tc.status == DNSSECBogus {
+			_, ok := err.(DNSSECError)
+			test.Assert(t, ok, "Bogus response didn't give a DNSSECError for "+tc.name)
+		} else {
+			test.AssertNotError(t, err, tc.name)
+		}
+	}
+
+	txts, status, _, err := resolver.LookupTXTStatus("_acme-challenge.secure.test")
+	test.AssertNotError(t, err, "TXT lookup failed")
+	test.AssertEquals(t, status, DNSSECSecure)
+	test.AssertEquals(t, len(txts), 1)
+	test.AssertEquals(t, txts[0], "token")
+
+	_, _, err = resolver.LookupTXT("tampered.secure.test")
+	_, ok := err.(DNSSECError)
+	test.Assert(t, ok, "Tampered TXT record was accepted")
+
+	// Names outside the trust anchor can't be validated
+	resolver.Validator, err = NewDNSSECValidator(resolver, []string{strings.Replace(anchor, "test.", "example.", 1)})
+	test.AssertNotError(t, err, "Failed to create validator")
+	_, status, _, err = resolver.LookupTXTStatus("_acme-challenge.secure.test")
+	test.AssertNotError(t, err, "TXT lookup failed")
+	test.AssertEquals(t, status, DNSSECInsecure)
+
+	// Without a validator nothing is checked
+	resolver.Validator = nil
+	_, status, _, err = resolver.LookupTXTStatus("tampered.secure.test")
+	test.AssertNotError(t, err, "TXT lookup failed")
+	test.AssertEquals(t, status, DNSSECUnchecked)
+}
+
+func TestDNSSECForgedDenial(t *testing.T) {
+	zones, anchor := dnssecTestZones(t)
+	// Answer NXDOMAIN for an existing name, with no denial proof
+	handler := dnssecHandler(zones)
+	server := startTestDNSServer(t, func(w dns.ResponseWriter, r *dns.Msg) {
+		if strings.EqualFold(r.Question[0].Name, "_acme-challenge.secure.test.") {
+			m := new(dns.Msg)
+			m.SetReply(r)
+			m.Rcode = dns.RcodeNameError
+			m.Ns = zones[0].soa()
+			w.WriteMsg(m)
+			return
+		}
+		handler(w, r)
+	})
+	defer server.stop()
+
+	resolver := NewDNSResolver(time.Second, []string{server.addr})
+	var err error
+	resolver.Validator, err = NewDNSSECValidator(resolver, []string{anchor})
+	test.AssertNotError(t, err, "Failed to create validator")
+
+	_, status, _, err := resolver.LookupTXTStatus("_acme-challenge.secure.test")
+	test.AssertEquals(t, status, DNSSECBogus)
+	_, ok := err.(DNSSECError)
+	test.Assert(t, ok, "Forged NXDOMAIN was accepted")
+}
+
+func TestNewDNSSECValidator(t *testing.T) {
+	resolver := NewDNSResolver(time.Second, []string{"127.0.0.1:53"})
+
+	_, err := NewDNSSECValidator(resolver, nil)
+	test.AssertError(t, err, "Created a validator without trust anchors")
+	_, err = NewDNSSECValidator(resolver, []string{"not a record"})
+	test.AssertError(t, err, "Accepted a malformed trust anchor")
+	_, err = NewDNSSECValidator(resolver, []string{"example. 300 IN A 192.0.2.1"})
+	test.AssertError(t, err, "Accepted an A record as a trust anchor")
+
+	v, err := NewDNSSECValidator(resolver, []string{
+		". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	})
+	test.AssertNotError(t, err, "Failed to load the root trust anchor")
+	test.AssertEquals(t, len(v.anchors["."]), 1)
+}
+
+func TestCanonicalOrder(t *testing.T) {
+	// The example ordering from RFC 4034 Section 6.1
+	names := []string{
+		"example.", "a.example.", "yljkjljk.a.example.", "z.a.example.",
+		"zabc.a.example.", "z.example.", "*.z.example.", "\\200.z.example.",
+	}
+	for i := 1; i < len(names); i++ {
+		test.Assert(t, canonicalCompare(names[i-1], names[i]) < 0, names[i-1]+" doesn't sort before "+names[i])
+	}
+
+	nsec := &dns.NSEC{Hdr: dns.RR_Header{Name: "a.example."}, NextDomain: "z.example."}
+	test.Assert(t, nsecCovers(nsec, "b.example."), "NSEC doesn't cover b.example.")
+	test.Assert(t, nsecCovers(nsec, "zabc.a.example."), "NSEC doesn't cover zabc.a.example.")
+	test.Assert(t, !nsecCovers(nsec, "a.example."), "NSEC covers its owner")
+	test.Assert(t, !nsecCovers(nsec, "z.example."), "NSEC covers its next name")
+	last := &dns.NSEC{Hdr: dns.RR_Header{Name: "z.example."}, NextDomain: "example."}
+	test.Assert(t, nsecCovers(last, "zz.example."), "Last NSEC doesn't wrap around")
+	test.Assert(t, !nsecCovers(last, "b.example."), "Last NSEC covers names before it")
+}
+
+func TestNSEC3Iterations(t *testing.T) {
+	nsec3 := &dns.NSEC3{
+		Hash:       dns.SHA1,
+		Iterations: maxNSEC3Iterations,
+		Salt:       "AABBCCDD",
+		TypeBitMap: []uint16{dns.TypeA},
+	}
+	nsec3.Hdr.Name = strings.ToLower(dns.HashName("example.", dns.SHA1, maxNSEC3Iterations, "AABBCCDD")) + ".example."
+	nsec3.NextDomain = dns.HashName("example.", dns.SHA1, maxNSEC3Iterations, "AABBCCDD")
+	test.Assert(t, nsec3Matches(nsec3, "example."), "NSEC3 at the iteration limit doesn't match its owner")
+	test.AssertEquals(t, nsec3Denial("example.", dns.TypeTXT, []*dns.NSEC3{nsec3}), denialNoData)
+
+	// Above the limit nothing is hashed, and the denial is insecure
+	nsec3.Iterations = maxNSEC3Iterations + 1
+	test.AssertEquals(t, nsec3Hash(nsec3, "example."), "")
+	test.AssertEquals(t, nsec3Denial("example.", dns.TypeTXT, []*dns.NSEC3{nsec3}), denialTooManyIterations)
+}
+
+func TestNSEC3IterationsDelegation(t *testing.T) {
+	parent := newSignedZone(t, "test.", true, true)
+	parent.iterations = maxNSEC3Iterations + 1
+	insecure := newSignedZone(t, "insecure.test.", false, false)
+	insecure.add(testRR(t, `_acme-challenge.insecure.test. 300 IN TXT "token"`))
+	parent.delegate(t, insecure)
+	parent.finish(t)
+	insecure.finish(t)
+
+	server := startTestDNSServer(t, dnssecHandler([]*signedZone{insecure, parent}))
+	defer server.stop()
+	resolver := NewDNSResolver(time.Second, []string{server.addr})
+	var err error
+	resolver.Validator, err = NewDNSSECValidator(resolver, []string{parent.key.ToDS(dns.SHA256).String()})
+	test.AssertNotError(t, err, "Failed to create validator")
+
+	// A parent whose NSEC3 chain is too costly to check can't prove the
+	// delegation unsigned, so names below it are insecure rather than bogus
+	_, status, _, err := resolver.LookupTXTStatus("_acme-challenge.insecure.test")
+	test.AssertNotError(t, err, "TXT lookup failed")
+	test.AssertEquals(t, status, DNSSECInsecure)
+}
@@ -79,15 +79,19 @@ type RegistrationAuthority interface {
 type ValidationAuthority interface {
 	// [RegistrationAuthority]
 	UpdateValidations(Authorization, int) error
-	CheckCAARecords(AcmeIdentifier) (bool, bool, error)
+	// CheckCAARecords takes the identifier, the ID of the requesting
+	// registration, and the challenge types used to validate the identifier
+	// (nil if it has not been validated yet).
+	CheckCAARecords(AcmeIdentifier, int64, []string) (bool, bool, error)
 }
 
 // CertificateAuthority defines the public interface for the Boulder CA
 type CertificateAuthority interface {
 	// [RegistrationAuthority]
-	IssueCertificate(x509.CertificateRequest, int64, time.Time) (Certificate, error)
+	IssueCertificate(x509.CertificateRequest, int64, time.Time, string) (Certificate, error)
 	RevokeCertificate(string, int) error
 	GenerateOCSP(OCSPSigningRequest) ([]byte, error)
+	GenerateCRL(CRLSigningRequest) ([]byte, error)
 }
 
 // PolicyAuthority defines the public interface for the Boulder PA
@@ -104,6 +108,7 @@ type StorageGetter interface {
 	GetCertificate(string) (Certificate, error)
 	GetCertificateByShortSerial(string) (Certificate, error)
 	GetCertificateStatus(string) (CertificateStatus, error)
+	GetSCTReceipts(string) ([]SignedCertificateTimestamp, error)
 	AlreadyDeniedCSR([]string) (bool, error)
 }
 
@@ -118,7 +123,8 @@ type StorageAdder interface {
 	MarkCertificateRevoked(serial string, ocspResponse []byte, reasonCode int) error
 	UpdateOCSP(serial string, ocspResponse []byte) error
 
-	AddCertificate([]byte, int64) (string, error)
+	AddCertificate([]byte, int64, string) (string, error)
+	AddSCTReceipt(SignedCertificateTimestamp) error
 }
 
 // StorageAuthority interface represents a simple key/value
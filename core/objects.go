@@ -47,6 +47,20 @@ const (
 	OCSPStatusRevoked = OCSPStatus("revoked")
 )
 
+// Paths are the ACME-spec identified URL path-segments for various methods
+const (
+	NewRegPath     = "/acme/new-reg"
+	RegPath        = "/acme/reg/"
+	NewAuthzPath   = "/acme/new-authz"
+	AuthzPath      = "/acme/authz/"
+	NewCertPath    = "/acme/new-cert"
+	CertPath       = "/acme/cert/"
+	RevokeCertPath = "/acme/revoke-cert"
+	TermsPath      = "/terms"
+	IssuerPath     = "/acme/issuer-cert"
+	BuildIDPath    = "/build"
+)
+
 // These types are the available challenges
 const (
 	ChallengeTypeSimpleHTTP    = "simpleHttp"
@@ -58,6 +72,7 @@ const (
 // These types are the available identification mechanisms
 const (
 	IdentifierDNS = IdentifierType("dns")
+	IdentifierIP  = IdentifierType("ip")
 )
 
 func cmpStrSlice(a, b []string) bool {
@@ -109,13 +124,23 @@ func cmpIPSlice(a, b []net.IP) bool {
 // An AcmeIdentifier encodes an identifier that can
 // be validated by ACME.  The protocol allows for different
 // types of identifier to be supported (DNS names, IP
-// addresses, etc.), but currently we only support
-// domain names.
+// addresses, etc.); we support domain names and IP
+// addresses.
 type AcmeIdentifier struct {
 	Type  IdentifierType `json:"type"`  // The type of identifier being encoded
 	Value string         `json:"value"` // The identifier itself
 }
 
+// IdentifierForName returns the identifier that must be authorized in order
+// to include a CSR name in a certificate.  Names that parse as IP addresses
+// are issued as IP SANs, so they map to an IP identifier in canonical form.
+func IdentifierForName(name string) AcmeIdentifier {
+	if ip := net.ParseIP(name); ip != nil {
+		return AcmeIdentifier{Type: IdentifierIP, Value: ip.String()}
+	}
+	return AcmeIdentifier{Type: IdentifierDNS, Value: name}
+}
+
 // CertificateRequest is just a CSR together with
 // URIs pointing to authorizations that should collectively
 // authorize the certificate being requsted.
@@ -127,11 +152,13 @@ type AcmeIdentifier struct {
 type CertificateRequest struct {
 	CSR            *x509.CertificateRequest // The CSR
 	Authorizations []AcmeURL                // Links to Authorization over the account key
+	Profile        string                   // Name of the issuance profile asked for, if any
 }
 
 type rawCertificateRequest struct {
-	CSR            JSONBuffer `json:"csr"`            // The encoded CSR
-	Authorizations []AcmeURL  `json:"authorizations"` // Authorizations
+	CSR            JSONBuffer `json:"csr"`               // The encoded CSR
+	Authorizations []AcmeURL  `json:"authorizations"`    // Authorizations
+	Profile        string     `json:"profile,omitempty"` // Issuance profile
 }
 
 // UnmarshalJSON provides an implementation for decoding CertificateRequest objects.
@@ -148,6 +175,7 @@ func (cr *CertificateRequest) UnmarshalJSON(data []byte) error {
 
 	cr.CSR = csr
 	cr.Authorizations = raw.Authorizations
+	cr.Profile = raw.Profile
 	return nil
 }
 
@@ -156,6 +184,7 @@ func (cr CertificateRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(rawCertificateRequest{
 		CSR:            cr.CSR.Raw,
 		Authorizations: cr.Authorizations,
+		Profile:        cr.Profile,
 	})
 }
 
@@ -371,6 +400,9 @@ type Authorization struct {
 	// The server may suggest combinations of challenges if it
 	// requires more than one challenge to be completed.
 	Combinations [][]int `json:"combinations,omitempty" db:"combinations"`
+
+	// The time at which CAA records for the identifier were last checked
+	CAAChecked *time.Time `json:"caaChecked,omitempty" db:"caaChecked"`
 }
 
 // JSONBuffer fields get encoded and decoded JOSE-style, in base64url encoding
@@ -421,10 +453,13 @@ type Certificate struct {
 	DER     JSONBuffer `db:"der"`
 	Issued  time.Time  `db:"issued"`
 	Expires time.Time  `db:"expires"`
+
+	// The name of the issuance profile the certificate was issued with
+	Profile string `db:"profile"`
 }
 
 // MatchesCSR tests the contents of a generated certificate to make sure
-// that the PublicKey, CommonName, and DNSNames match those provided in
+// that the PublicKey, CommonName, DNSNames, and IPAddresses match those provided in
 // the CSR that was used to generate the certificate. It also checks the
 // following fields for:
 //		* notAfter is after earliestExpiry
@@ -433,19 +468,29 @@ type Certificate struct {
 //		* IsCA is false
 //		* ExtKeyUsage only contains ExtKeyUsageServerAuth & ExtKeyUsageClientAuth
 //		* Subject only contains CommonName & Names
+//		* OCSP Must-Staple is present if and only if the CSR asked for it
 func (cert Certificate) MatchesCSR(csr *x509.CertificateRequest, earliestExpiry time.Time) (err error) {
 	parsedCertificate, err := x509.ParseCertificate([]byte(cert.DER))
 	if err != nil {
 		return
 	}
 
-	// Check issued certificate matches what was expected from the CSR
+	// Check issued certificate matches what was expected from the CSR. A
+	// CommonName that is an IP address literal is carried as an IP SAN rather
+	// than a DNS SAN.
 	hostNames := make([]string, len(csr.DNSNames))
 	copy(hostNames, csr.DNSNames)
+	ipAddresses := make([]net.IP, len(csr.IPAddresses))
+	copy(ipAddresses, csr.IPAddresses)
 	if len(csr.Subject.CommonName) > 0 {
-		hostNames = append(hostNames, csr.Subject.CommonName)
+		if ip := net.ParseIP(csr.Subject.CommonName); ip != nil {
+			ipAddresses = append(ipAddresses, ip)
+		} else {
+			hostNames = append(hostNames, csr.Subject.CommonName)
+		}
 	}
 	hostNames = UniqueNames(hostNames)
+	ipAddresses = UniqueIPs(ipAddresses)
 
 	if !KeyDigestEquals(parsedCertificate.PublicKey, csr.PublicKey) {
 		err = InternalServerError("Generated certificate public key doesn't match CSR public key")
@@ -459,7 +504,7 @@ func (cert Certificate) MatchesCSR(csr *x509.CertificateRequest, earliestExpiry
 		err = InternalServerError("Generated certificate DNSNames don't match CSR DNSNames")
 		return
 	}
-	if !cmpIPSlice(parsedCertificate.IPAddresses, csr.IPAddresses) {
+	if !cmpIPSlice(parsedCertificate.IPAddresses, ipAddresses) {
 		err = InternalServerError("Generated certificate IPAddresses don't match CSR IPAddresses")
 		return
 	}
@@ -495,6 +540,23 @@ func (cert Certificate) MatchesCSR(csr *x509.CertificateRequest, earliestExpiry
 		err = InternalServerError("Generated certificate doesn't have correct key usage extensions")
 		return
 	}
+	requested, err := RequestsMustStaple(csr)
+	if err != nil {
+		return
+	}
+	present, err := HasMustStaple(parsedCertificate)
+	if err != nil {
+		err = InternalServerError(fmt.Sprintf("Generated certificate has an invalid TLS Feature extension: %s", err))
+		return
+	}
+	if requested && !present {
+		err = InternalServerError("Generated certificate doesn't have the OCSP Must-Staple extension requested")
+		return
+	}
+	if present && !requested {
+		err = InternalServerError("Generated certificate has an OCSP Must-Staple extension that wasn't requested")
+		return
+	}
 
 	return
 }
@@ -551,14 +613,24 @@ type OCSPResponse struct {
 // we've signed, is append-only, and is likely to get quite large.
 // It must be administratively truncated outside of Boulder.
 type CRL struct {
-	// serial: Same as certificate serial.
-	Serial string `db:"serial"`
+	ID int64 `db:"id"`
+
+	// issuer: The name of the issuer the CRL is signed by.
+	Issuer string `db:"issuer"`
+
+	// number: The CRL number, which increases with each CRL signed for the
+	//   issuer.
+	Number int64 `db:"number"`
+
+	// thisUpdate, nextUpdate: The validity period of the CRL.
+	ThisUpdate time.Time `db:"thisUpdate"`
+	NextUpdate time.Time `db:"nextUpdate"`
 
 	// createdAt: The date the CRL was signed.
 	CreatedAt time.Time `db:"createdAt"`
 
-	// crl: The encoded and signed CRL.
-	CRL string `db:"crl"`
+	// crl: The DER-encoded and signed CRL.
+	CRL []byte `db:"crl"`
 }
 
 // DeniedCSR is a list of names we deny issuing.
@@ -568,6 +640,63 @@ type DeniedCSR struct {
 	Names string `db:"names"`
 }
 
+// SignedCertificateTimestamp is a promise by a Certificate Transparency log to
+// include a certificate, returned when the certificate or its precertificate is
+// submitted. See RFC 6962, section 3.2.
+type SignedCertificateTimestamp struct {
+	ID int `db:"id"`
+
+	// sctVersion: Version of the SCT structure; currently always 0 (v1).
+	SCTVersion uint8 `db:"sctVersion"`
+
+	// logID: The SHA-256 hash of the log's public key, base64 encoded.
+	LogID string `db:"logID"`
+
+	// timestamp: Milliseconds since the epoch at which the log accepted the
+	// submission.
+	Timestamp uint64 `db:"timestamp"`
+
+	// extensions: Opaque CT extensions, currently always empty.
+	Extensions []byte `db:"extensions"`
+
+	// signature: The log's TLS-encoded DigitallySigned signature.
+	Signature []byte `db:"signature"`
+
+	// certificateSerial: Serial of the certificate the SCT was issued for.
+	CertificateSerial string `db:"certificateSerial"`
+}
+
+// CTSubmission tracks the submission of an issued certificate to one CT log.
+// Its status is pending while submission is being attempted, valid once the
+// log has returned an SCT and invalid if submission was given up on.
+type CTSubmission struct {
+	ID int64 `db:"id"`
+
+	// serial: Serial of the submitted certificate.
+	Serial string `db:"serial"`
+
+	// logID: The SHA-256 hash of the log's public key, base64 encoded.
+	LogID string `db:"logID"`
+
+	Status AcmeStatus `db:"status"`
+
+	// attempts: How many times submission has been attempted.
+	Attempts int `db:"attempts"`
+
+	// nextAttempt: When submission is next due, while pending.
+	NextAttempt time.Time `db:"nextAttempt"`
+
+	// error: Why the last attempt failed, if it did.
+	Error string `db:"error"`
+
+	// timestamp, extensions, signature: The SCT the log returned, once valid.
+	Timestamp  uint64 `db:"timestamp"`
+	Extensions []byte `db:"extensions"`
+	Signature  []byte `db:"signature"`
+
+	LockCol int64
+}
+
 // OCSPSigningRequest is a transfer object representing an OCSP Signing Request
 type OCSPSigningRequest struct {
 	CertDER   []byte
@@ -575,3 +704,20 @@ type OCSPSigningRequest struct {
 	Reason    int
 	RevokedAt time.Time
 }
+
+// CRLSigningRequest is a transfer object representing a request to sign a
+// CRL with the named issuer
+type CRLSigningRequest struct {
+	Issuer       string
+	Number       int64
+	ThisUpdate   time.Time
+	NextUpdate   time.Time
+	RevokedCerts []RevokedCertificate
+}
+
+// RevokedCertificate is an entry in a CRL
+type RevokedCertificate struct {
+	Serial    string
+	RevokedAt time.Time
+	Reason    int
+}
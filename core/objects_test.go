@@ -111,3 +111,9 @@ func TestJSONBufferUnmarshal(t *testing.T) {
 	err := json.Unmarshal(notValidBase64, &testStruct)
 	test.Assert(t, err != nil, "Should have choked on invalid base64")
 }
+
+func TestIdentifierForName(t *testing.T) {
+	test.AssertEquals(t, IdentifierForName("example.com"), AcmeIdentifier{Type: IdentifierDNS, Value: "example.com"})
+	test.AssertEquals(t, IdentifierForName("8.8.8.8"), AcmeIdentifier{Type: IdentifierIP, Value: "8.8.8.8"})
+	test.AssertEquals(t, IdentifierForName("2001:4860:4860:0:0:0:0:8888"), AcmeIdentifier{Type: IdentifierIP, Value: "2001:4860:4860::8888"})
+}
@@ -8,12 +8,14 @@ package core
 import (
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
@@ -24,6 +26,7 @@ import (
 	"hash"
 	"io"
 	"math/big"
+	"net"
 	"net/url"
 	"strings"
 )
@@ -170,6 +173,26 @@ func KeyDigestEquals(j, k crypto.PublicKey) bool {
 	return digestJ == digestK
 }
 
+// SignatureAlgorithmForKey chooses the algorithm for certificates, CRLs and
+// OCSP responses signed with a private key, given its public key.  RSA keys
+// sign with SHA-256, and ECDSA keys with the hash that matches their curve:
+// SHA-256 for P-256 and SHA-384 for P-384.  Other keys can't be used to sign.
+func SignatureAlgorithmForKey(key crypto.PublicKey) (x509.SignatureAlgorithm, error) {
+	switch t := key.(type) {
+	case *rsa.PublicKey:
+		return x509.SHA256WithRSA, nil
+	case *ecdsa.PublicKey:
+		switch t.Curve {
+		case elliptic.P256():
+			return x509.ECDSAWithSHA256, nil
+		case elliptic.P384():
+			return x509.ECDSAWithSHA384, nil
+		}
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("Unsupported ECDSA curve %s for signing", t.Params().Name)
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("Unsupported key type %T for signing", key)
+}
+
 // AcmeURL is a URL that automatically marshal/unmarshal to JSON strings
 type AcmeURL url.URL
 
@@ -275,23 +298,94 @@ func VerifyCSR(csr *x509.CertificateRequest) error {
 	return errors.New("Unsupported CSR signing algorithm")
 }
 
+// TLSFeatureOID identifies the TLS Feature extension (RFC 7633), which lists
+// TLS extensions a server using the certificate must support.
+var TLSFeatureOID = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 1, 24}
+
+// tlsFeatureStatusRequest is the status_request TLS extension (RFC 6066),
+// with which a server staples OCSP responses.
+const tlsFeatureStatusRequest = 5
+
+// MustStapleExtension is the TLS Feature extension requiring the
+// status_request feature, also known as OCSP Must-Staple.
+var MustStapleExtension = pkix.Extension{Id: TLSFeatureOID, Value: []byte{0x30, 0x03, 0x02, 0x01, tlsFeatureStatusRequest}}
+
+// RequestsMustStaple returns true if the CSR asks for OCSP Must-Staple. A TLS
+// Feature extension listing anything other than status_request is an error,
+// since only that feature is supported.
+func RequestsMustStaple(csr *x509.CertificateRequest) (bool, error) {
+	for _, ext := range csr.Extensions {
+		if ext.Id.Equal(TLSFeatureOID) {
+			return hasMustStaple(ext)
+		}
+	}
+	return false, nil
+}
+
+// HasMustStaple returns true if the certificate has a TLS Feature extension
+// requiring status_request.
+func HasMustStaple(cert *x509.Certificate) (bool, error) {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(TLSFeatureOID) {
+			return hasMustStaple(ext)
+		}
+	}
+	return false, nil
+}
+
+func hasMustStaple(ext pkix.Extension) (bool, error) {
+	var features []int
+	rest, err := asn1.Unmarshal(ext.Value, &features)
+	if err != nil || len(rest) > 0 {
+		return false, errors.New("Malformed TLS Feature extension")
+	}
+	if len(features) != 1 || features[0] != tlsFeatureStatusRequest {
+		return false, fmt.Errorf("Unsupported TLS features %v", features)
+	}
+	return true, nil
+}
+
 // SerialToString converts a certificate serial number (big.Int) to a String
-// consistently.
+// consistently. Serials are at least 32 characters long and always have an
+// even number of characters.
 func SerialToString(serial *big.Int) string {
-	return fmt.Sprintf("%032x", serial)
+	s := fmt.Sprintf("%032x", serial)
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	return s
 }
 
 // StringToSerial converts a string into a certificate serial number (big.Int)
 // consistently.
 func StringToSerial(serial string) (*big.Int, error) {
 	var serialNum big.Int
-	if len(serial) != 32 {
-		return &serialNum, errors.New("Serial number should be 32 characters long")
+	if !ValidSerial(serial) {
+		return &serialNum, errors.New("Serial number should be 32 or 34 characters long")
 	}
-	_, err := fmt.Sscanf(serial, "%032x", &serialNum)
+	_, err := fmt.Sscanf(serial, "%x", &serialNum)
 	return &serialNum, err
 }
 
+// ValidSerial tests whether the input string represents a full serial number,
+// either a 128-bit sequential one or a 136-bit random one.
+func ValidSerial(serial string) bool {
+	return len(serial) == 32 || len(serial) == 34
+}
+
+// ShortSerial returns the part of a serial number that precedes the 64 bits
+// appended by the signer. Certificate URLs are built from it.
+func ShortSerial(serial *big.Int) string {
+	s := SerialToString(serial)
+	return s[:len(s)-16]
+}
+
+// ValidShortSerial tests whether the input string represents a short serial,
+// either an old sequential one or a random one.
+func ValidShortSerial(shortSerial string) bool {
+	return len(shortSerial) == 16 || len(shortSerial) == 18
+}
+
 // GetBuildID identifies what build is running.
 func GetBuildID() (retID string) {
 	retID = BuildID
@@ -332,3 +426,17 @@ func UniqueNames(names []string) (unique []string) {
 	}
 	return
 }
+
+// UniqueIPs returns the set of all unique IP addresses in the input.
+func UniqueIPs(ips []net.IP) (unique []net.IP) {
+	ipMap := make(map[string]net.IP, len(ips))
+	for _, ip := range ips {
+		ipMap[ip.String()] = ip
+	}
+
+	unique = make([]net.IP, 0, len(ipMap))
+	for _, ip := range ipMap {
+		unique = append(unique, ip)
+	}
+	return
+}
@@ -6,12 +6,19 @@
 package core
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/json"
 	"fmt"
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/square/go-jose"
 	"github.com/letsencrypt/boulder/test"
 	"math"
 	"math/big"
+	"net"
 	"net/url"
 	"testing"
 )
@@ -43,8 +50,18 @@ func TestSerialUtils(t *testing.T) {
 	test.AssertBigIntEquals(t, serialNum, big.NewInt(100000000000000000))
 
 	badSerial, err := StringToSerial("doop!!!!000")
-	test.AssertEquals(t, fmt.Sprintf("%v", err), "Serial number should be 32 characters long")
+	test.AssertEquals(t, fmt.Sprintf("%v", err), "Serial number should be 32 or 34 characters long")
 	fmt.Println(badSerial)
+
+	long, _ := new(big.Int).SetString("0f0123456789abcdef0123456789abcdef", 16)
+	serial = SerialToString(long)
+	test.AssertEquals(t, serial, "0f0123456789abcdef0123456789abcdef")
+	test.AssertEquals(t, ShortSerial(long), "0f0123456789abcdef")
+	serialNum, err = StringToSerial(serial)
+	test.AssertNotError(t, err, "Couldn't convert long serial number to *big.Int")
+	test.AssertBigIntEquals(t, serialNum, long)
+
+	test.AssertEquals(t, ShortSerial(big.NewInt(100000000000000000)), "0000000000000000")
 }
 
 func TestBuildID(t *testing.T) {
@@ -90,9 +107,80 @@ func TestKeyDigestEquals(t *testing.T) {
 	test.Assert(t, !KeyDigestEquals(struct{}{}, struct{}{}), "Unknown key types should not match anything")
 }
 
+func TestSignatureAlgorithmForKey(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 1024)
+	p256Key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384Key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	p521Key, _ := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+
+	alg, err := SignatureAlgorithmForKey(&rsaKey.PublicKey)
+	test.AssertNotError(t, err, "RSA key refused")
+	test.AssertEquals(t, alg, x509.SHA256WithRSA)
+	alg, err = SignatureAlgorithmForKey(&p256Key.PublicKey)
+	test.AssertNotError(t, err, "P-256 key refused")
+	test.AssertEquals(t, alg, x509.ECDSAWithSHA256)
+	alg, err = SignatureAlgorithmForKey(&p384Key.PublicKey)
+	test.AssertNotError(t, err, "P-384 key refused")
+	test.AssertEquals(t, alg, x509.ECDSAWithSHA384)
+
+	_, err = SignatureAlgorithmForKey(&p521Key.PublicKey)
+	test.AssertError(t, err, "P-521 key accepted")
+	_, err = SignatureAlgorithmForKey(nil)
+	test.AssertError(t, err, "Missing key accepted")
+}
+
+func TestMustStaple(t *testing.T) {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	csrWith := func(extensions ...pkix.Extension) *x509.CertificateRequest {
+		der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+			Subject:         pkix.Name{CommonName: "not-example.com"},
+			ExtraExtensions: extensions,
+		}, key)
+		test.AssertNotError(t, err, "Failed to create CSR")
+		csr, err := x509.ParseCertificateRequest(der)
+		test.AssertNotError(t, err, "Failed to parse CSR")
+		return csr
+	}
+
+	requested, err := RequestsMustStaple(csrWith())
+	test.AssertNotError(t, err, "CSR without TLS Feature refused")
+	test.Assert(t, !requested, "Must-Staple found in CSR without it")
+
+	requested, err = RequestsMustStaple(csrWith(MustStapleExtension))
+	test.AssertNotError(t, err, "CSR with Must-Staple refused")
+	test.Assert(t, requested, "Must-Staple not found in CSR")
+
+	// status_request_v2 isn't supported
+	_, err = RequestsMustStaple(csrWith(pkix.Extension{Id: TLSFeatureOID, Value: []byte{0x30, 0x06, 0x02, 0x01, 0x05, 0x02, 0x01, 0x11}}))
+	test.AssertError(t, err, "Unsupported TLS feature accepted")
+	_, err = RequestsMustStaple(csrWith(pkix.Extension{Id: TLSFeatureOID, Value: []byte{0x05, 0x00}}))
+	test.AssertError(t, err, "Malformed TLS Feature extension accepted")
+
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		ExtraExtensions: []pkix.Extension{MustStapleExtension},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "Failed to create certificate")
+	cert, _ := x509.ParseCertificate(der)
+	present, err := HasMustStaple(cert)
+	test.AssertNotError(t, err, "Certificate with Must-Staple refused")
+	test.Assert(t, present, "Must-Staple not found in certificate")
+}
+
 func TestAcmeURL(t *testing.T) {
 	s := "http://example.invalid"
 	u, _ := url.Parse(s)
 	a := AcmeURL(*u)
 	test.AssertEquals(t, s, a.String())
 }
+
+func TestUniqueIPs(t *testing.T) {
+	ips := UniqueIPs([]net.IP{
+		net.ParseIP("8.8.8.8"),
+		net.ParseIP("2001:4860:4860::8888"),
+		net.ParseIP("8.8.8.8"),
+		net.ParseIP("2001:4860:4860:0:0:0:0:8888"),
+	})
+	test.AssertEquals(t, len(ips), 2)
+}
@@ -0,0 +1,478 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ct submits certificates and precertificates to Certificate
+// Transparency logs (RFC 6962), checks the SCTs the logs return and encodes
+// them for embedding in certificates.
+package ct
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// PoisonOID identifies the critical extension that makes a precertificate
+// unusable as a certificate.
+var PoisonOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
+
+// SCTListOID identifies the extension that embeds SCTs in a certificate.
+var SCTListOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 2}
+
+// PoisonExtension is added to a certificate template to sign a
+// precertificate. Its value is an ASN.1 NULL.
+var PoisonExtension = pkix.Extension{Id: PoisonOID, Critical: true, Value: []byte{0x05, 0x00}}
+
+// Entry types of the MerkleTreeLeaf a log signs in an SCT.
+const (
+	x509Entry    = 0
+	precertEntry = 1
+)
+
+// Algorithms of the DigitallySigned struct a log signs an SCT with.
+const (
+	hashSHA256   = 4
+	signatureRSA = 1
+	signatureEC  = 3
+)
+
+// DefaultSubmissionTimeout is how long to wait for a log to return an SCT if
+// no other timeout is configured.
+const DefaultSubmissionTimeout = 10 * time.Second
+
+// Config defines the JSON configuration of the logs to submit to.
+type Config struct {
+	Logs []LogDescription
+	// The number of SCTs, from distinct logs, that must be collected before a
+	// certificate is issued. Zero means one from each log.
+	SCTsRequired int
+	// How long to wait for a log to return an SCT
+	SubmissionTimeout string
+}
+
+// LogDescription identifies a CT log to submit to.
+type LogDescription struct {
+	// Base URI of the log, to which /ct/v1/... paths are appended
+	URI string
+	// Base64-encoded DER SubjectPublicKeyInfo of the log's key
+	Key string
+}
+
+// Log is a CT log that certificates can be submitted to.
+type Log struct {
+	URI string
+	// ID is the SHA-256 hash of the log's public key, as found in its SCTs.
+	ID     []byte
+	key    crypto.PublicKey
+	client http.Client
+}
+
+// NewLog creates a Log from its description.
+func NewLog(desc LogDescription, timeout time.Duration) (*Log, error) {
+	keyDER, err := base64.StdEncoding.DecodeString(desc.Key)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid key for CT log %s: %s", desc.URI, err)
+	}
+	key, err := x509.ParsePKIXPublicKey(keyDER)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid key for CT log %s: %s", desc.URI, err)
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("Unsupported key type for CT log %s", desc.URI)
+	}
+	id := sha256.Sum256(keyDER)
+	return &Log{
+		URI:    strings.TrimRight(desc.URI, "/"),
+		ID:     id[:],
+		key:    key,
+		client: http.Client{Timeout: timeout},
+	}, nil
+}
+
+// addChainRequest is the body of add-chain and add-pre-chain requests.
+// Certificates are base64 encoded by the JSON encoder.
+type addChainRequest struct {
+	Chain [][]byte `json:"chain"`
+}
+
+// addChainResponse is the SCT a log returns from add-chain and add-pre-chain.
+type addChainResponse struct {
+	SCTVersion uint8  `json:"sct_version"`
+	ID         []byte `json:"id"`
+	Timestamp  uint64 `json:"timestamp"`
+	Extensions []byte `json:"extensions"`
+	Signature  []byte `json:"signature"`
+}
+
+// submit posts a chain to one of the log's add-chain endpoints and returns
+// the SCT the log responds with.
+func (log *Log) submit(endpoint string, chain [][]byte) (*addChainResponse, error) {
+	body, err := json.Marshal(addChainRequest{Chain: chain})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := log.client.Post(log.URI+"/ct/v1/"+endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("CT log %s returned %d: %s", log.URI, resp.StatusCode, respBody)
+	}
+
+	var sct addChainResponse
+	if err = json.Unmarshal(respBody, &sct); err != nil {
+		return nil, fmt.Errorf("Invalid SCT from CT log %s: %s", log.URI, err)
+	}
+	if sct.SCTVersion != 0 {
+		return nil, fmt.Errorf("Unsupported SCT version %d from CT log %s", sct.SCTVersion, log.URI)
+	}
+	if !bytes.Equal(sct.ID, log.ID) {
+		return nil, fmt.Errorf("SCT from CT log %s has the wrong log ID", log.URI)
+	}
+	return &sct, nil
+}
+
+// verify checks the log's signature on an SCT over a log entry, encoded as
+// described in RFC 6962, section 3.2.
+func (log *Log) verify(sct *addChainResponse, entryType uint16, entry []byte) error {
+	var signed bytes.Buffer
+	signed.WriteByte(sct.SCTVersion)
+	signed.WriteByte(0) // signature_type: certificate_timestamp
+	binary.Write(&signed, binary.BigEndian, sct.Timestamp)
+	binary.Write(&signed, binary.BigEndian, entryType)
+	signed.Write(entry)
+	binary.Write(&signed, binary.BigEndian, uint16(len(sct.Extensions)))
+	signed.Write(sct.Extensions)
+	digest := sha256.Sum256(signed.Bytes())
+
+	// The signature is a TLS DigitallySigned struct: hash and signature
+	// algorithms, then the length-prefixed signature itself.
+	if len(sct.Signature) < 4 {
+		return fmt.Errorf("SCT from CT log %s has a truncated signature", log.URI)
+	}
+	hashAlg, sigAlg := sct.Signature[0], sct.Signature[1]
+	sigLen := int(binary.BigEndian.Uint16(sct.Signature[2:4]))
+	sig := sct.Signature[4:]
+	if hashAlg != hashSHA256 || len(sig) != sigLen {
+		return fmt.Errorf("SCT from CT log %s has a malformed signature", log.URI)
+	}
+
+	switch key := log.key.(type) {
+	case *rsa.PublicKey:
+		if sigAlg != signatureRSA {
+			break
+		}
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+			return fmt.Errorf("SCT from CT log %s has an invalid signature: %s", log.URI, err)
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if sigAlg != signatureEC {
+			break
+		}
+		var ecdsaSig struct {
+			R, S *big.Int
+		}
+		if rest, err := asn1.Unmarshal(sig, &ecdsaSig); err != nil || len(rest) > 0 {
+			return fmt.Errorf("SCT from CT log %s has a malformed signature", log.URI)
+		}
+		if !ecdsa.Verify(key, digest[:], ecdsaSig.R, ecdsaSig.S) {
+			return fmt.Errorf("SCT from CT log %s has an invalid signature", log.URI)
+		}
+		return nil
+	}
+	return fmt.Errorf("SCT from CT log %s is signed with the wrong algorithm", log.URI)
+}
+
+// SubmitPrecertificate submits a precertificate and its issuer to the log
+// with add-pre-chain, and returns the verified SCT.
+func (log *Log) SubmitPrecertificate(precert, issuer *x509.Certificate) (core.SignedCertificateTimestamp, error) {
+	tbs, err := removeExtension(precert.RawTBSCertificate, PoisonOID)
+	if err != nil {
+		return core.SignedCertificateTimestamp{}, err
+	}
+	issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	entry := append(issuerKeyHash[:], uint24Prefixed(tbs)...)
+
+	sct, err := log.submit("add-pre-chain", [][]byte{precert.Raw, issuer.Raw})
+	if err != nil {
+		return core.SignedCertificateTimestamp{}, err
+	}
+	if err = log.verify(sct, precertEntry, entry); err != nil {
+		return core.SignedCertificateTimestamp{}, err
+	}
+	return toSCT(sct, precert), nil
+}
+
+// SubmitCertificate submits a certificate and its issuer to the log with
+// add-chain, and returns the verified SCT.
+func (log *Log) SubmitCertificate(cert, issuer *x509.Certificate) (core.SignedCertificateTimestamp, error) {
+	sct, err := log.submit("add-chain", [][]byte{cert.Raw, issuer.Raw})
+	if err != nil {
+		return core.SignedCertificateTimestamp{}, err
+	}
+	if err = log.verify(sct, x509Entry, uint24Prefixed(cert.Raw)); err != nil {
+		return core.SignedCertificateTimestamp{}, err
+	}
+	return toSCT(sct, cert), nil
+}
+
+// VerifyEmbeddedSCT checks an SCT the log returned for a precertificate
+// against the certificate it is embedded in. The log signed the certificate's
+// TBSCertificate as it is without the SCT list extension.
+func (log *Log) VerifyEmbeddedSCT(sct core.SignedCertificateTimestamp, cert, issuer *x509.Certificate) error {
+	id, err := base64.StdEncoding.DecodeString(sct.LogID)
+	if err != nil || !bytes.Equal(id, log.ID) {
+		return fmt.Errorf("SCT wasn't issued by CT log %s", log.URI)
+	}
+	tbs, err := removeExtension(cert.RawTBSCertificate, SCTListOID)
+	if err != nil {
+		return err
+	}
+	issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+	entry := append(issuerKeyHash[:], uint24Prefixed(tbs)...)
+
+	return log.verify(&addChainResponse{
+		SCTVersion: sct.SCTVersion,
+		ID:         id,
+		Timestamp:  sct.Timestamp,
+		Extensions: sct.Extensions,
+		Signature:  sct.Signature,
+	}, precertEntry, entry)
+}
+
+func toSCT(sct *addChainResponse, cert *x509.Certificate) core.SignedCertificateTimestamp {
+	return core.SignedCertificateTimestamp{
+		SCTVersion:        sct.SCTVersion,
+		LogID:             base64.StdEncoding.EncodeToString(sct.ID),
+		Timestamp:         sct.Timestamp,
+		Extensions:        sct.Extensions,
+		Signature:         sct.Signature,
+		CertificateSerial: core.SerialToString(cert.SerialNumber),
+	}
+}
+
+// Submitter submits precertificates to a set of logs, on behalf of the CA.
+type Submitter struct {
+	Logs []*Log
+	// SCTsRequired is how many logs must return an SCT for a submission to
+	// succeed.
+	SCTsRequired int
+	log          *blog.AuditLogger
+}
+
+// NewSubmitter creates a Submitter for the configured logs. It returns nil if
+// no logs are configured.
+func NewSubmitter(config Config) (*Submitter, error) {
+	if len(config.Logs) == 0 {
+		return nil, nil
+	}
+
+	timeout := DefaultSubmissionTimeout
+	if config.SubmissionTimeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(config.SubmissionTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	submitter := &Submitter{
+		SCTsRequired: config.SCTsRequired,
+		log:          blog.GetAuditLogger(),
+	}
+	for _, desc := range config.Logs {
+		log, err := NewLog(desc, timeout)
+		if err != nil {
+			return nil, err
+		}
+		submitter.Logs = append(submitter.Logs, log)
+	}
+	if submitter.SCTsRequired == 0 {
+		submitter.SCTsRequired = len(submitter.Logs)
+	}
+	if submitter.SCTsRequired > len(submitter.Logs) {
+		return nil, fmt.Errorf("%d SCTs required but only %d CT logs configured", submitter.SCTsRequired, len(submitter.Logs))
+	}
+	return submitter, nil
+}
+
+// SubmitPrecertificate submits a precertificate to all of the logs at once,
+// and returns as soon as SCTsRequired of them have returned valid SCTs. It
+// fails if too many logs fail for that to happen.
+func (s *Submitter) SubmitPrecertificate(precertDER []byte, issuer *x509.Certificate) ([]core.SignedCertificateTimestamp, error) {
+	precert, err := x509.ParseCertificate(precertDER)
+	if err != nil {
+		return nil, err
+	}
+
+	type result struct {
+		sct core.SignedCertificateTimestamp
+		err error
+	}
+	// Buffered so that logs still responding after we return don't block
+	results := make(chan result, len(s.Logs))
+	for _, log := range s.Logs {
+		go func(log *Log) {
+			sct, err := log.SubmitPrecertificate(precert, issuer)
+			results <- result{sct, err}
+		}(log)
+	}
+
+	var scts []core.SignedCertificateTimestamp
+	for i := 0; i < len(s.Logs); i++ {
+		r := <-results
+		if r.err != nil {
+			s.log.Warning(fmt.Sprintf("CT submission failed: serial=[%s] err=[%s]", core.SerialToString(precert.SerialNumber), r.err))
+			continue
+		}
+		scts = append(scts, r.sct)
+		if len(scts) == s.SCTsRequired {
+			return scts, nil
+		}
+	}
+	return nil, fmt.Errorf("Only %d of %d required SCTs were returned", len(scts), s.SCTsRequired)
+}
+
+// VerifyEmbeddedSCTs checks that SCTs the logs returned for a
+// precertificate are valid for the certificate they are embedded in.
+func (s *Submitter) VerifyEmbeddedSCTs(scts []core.SignedCertificateTimestamp, cert, issuer *x509.Certificate) error {
+	for _, sct := range scts {
+		var err = fmt.Errorf("SCT from unknown CT log %s", sct.LogID)
+		for _, log := range s.Logs {
+			if base64.StdEncoding.EncodeToString(log.ID) == sct.LogID {
+				err = log.VerifyEmbeddedSCT(sct, cert, issuer)
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SCTListExtension encodes SCTs as a SignedCertificateTimestampList, in the
+// extension that embeds them in a certificate (RFC 6962, section 3.3).
+func SCTListExtension(scts []core.SignedCertificateTimestamp) (pkix.Extension, error) {
+	var list bytes.Buffer
+	for _, sct := range scts {
+		logID, err := base64.StdEncoding.DecodeString(sct.LogID)
+		if err != nil || len(logID) != sha256.Size {
+			return pkix.Extension{}, fmt.Errorf("Invalid log ID %s", sct.LogID)
+		}
+		var serialized bytes.Buffer
+		serialized.WriteByte(sct.SCTVersion)
+		serialized.Write(logID)
+		binary.Write(&serialized, binary.BigEndian, sct.Timestamp)
+		binary.Write(&serialized, binary.BigEndian, uint16(len(sct.Extensions)))
+		serialized.Write(sct.Extensions)
+		serialized.Write(sct.Signature)
+
+		binary.Write(&list, binary.BigEndian, uint16(serialized.Len()))
+		list.Write(serialized.Bytes())
+	}
+	if list.Len() > 0xffff {
+		return pkix.Extension{}, errors.New("Too many SCTs to embed")
+	}
+
+	var tls bytes.Buffer
+	binary.Write(&tls, binary.BigEndian, uint16(list.Len()))
+	tls.Write(list.Bytes())
+	value, err := asn1.Marshal(tls.Bytes())
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: SCTListOID, Value: value}, nil
+}
+
+// uint24Prefixed prepends the TLS 24-bit length of data to it.
+func uint24Prefixed(data []byte) []byte {
+	l := len(data)
+	return append([]byte{byte(l >> 16), byte(l >> 8), byte(l)}, data...)
+}
+
+// removeExtension returns a DER TBSCertificate with the extension identified
+// by oid removed and everything else left untouched, as logs do to
+// precertificates before signing them.
+func removeExtension(tbsDER []byte, oid asn1.ObjectIdentifier) ([]byte, error) {
+	var tbs asn1.RawValue
+	if rest, err := asn1.Unmarshal(tbsDER, &tbs); err != nil || len(rest) > 0 {
+		return nil, errors.New("Malformed TBSCertificate")
+	}
+
+	var fields []byte
+	found := false
+	for rest := tbs.Bytes; len(rest) > 0; {
+		var field asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+			return nil, errors.New("Malformed TBSCertificate")
+		}
+		// Extensions are the only field tagged [3]
+		if field.Class != asn1.ClassContextSpecific || field.Tag != 3 {
+			fields = append(fields, field.FullBytes...)
+			continue
+		}
+
+		var extensions []asn1.RawValue
+		if _, err = asn1.Unmarshal(field.Bytes, &extensions); err != nil {
+			return nil, errors.New("Malformed TBSCertificate extensions")
+		}
+		var kept []byte
+		for _, raw := range extensions {
+			var ext pkix.Extension
+			if _, err = asn1.Unmarshal(raw.FullBytes, &ext); err != nil {
+				return nil, errors.New("Malformed TBSCertificate extension")
+			}
+			if ext.Id.Equal(oid) {
+				found = true
+				continue
+			}
+			kept = append(kept, raw.FullBytes...)
+		}
+		if len(kept) == 0 {
+			continue
+		}
+		seq, err := asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: kept})
+		if err != nil {
+			return nil, err
+		}
+		wrapped, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 3, IsCompound: true, Bytes: seq})
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, wrapped...)
+	}
+	if !found {
+		return nil, fmt.Errorf("TBSCertificate has no extension %v", oid)
+	}
+	return asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: fields})
+}
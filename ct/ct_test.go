@@ -0,0 +1,175 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ct
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// issue creates an issuer and a certificate from it, with the given extra
+// extensions.
+func issue(t *testing.T, extensions []pkix.Extension) (cert, issuer *x509.Certificate) {
+	issuerKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	issuerTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "CT test issuer"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, issuerTemplate, issuerTemplate, &issuerKey.PublicKey, issuerKey)
+	test.AssertNotError(t, err, "Failed to create issuer")
+	issuer, _ = x509.ParseCertificate(issuerDER)
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1234),
+		Subject:         pkix.Name{CommonName: "example.com"},
+		DNSNames:        []string{"example.com"},
+		NotBefore:       time.Now(),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: extensions,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	test.AssertNotError(t, err, "Failed to create certificate")
+	cert, err = x509.ParseCertificate(certDER)
+	test.AssertNotError(t, err, "Failed to parse certificate")
+	return
+}
+
+func TestRemoveExtension(t *testing.T) {
+	precert, _ := issue(t, []pkix.Extension{PoisonExtension})
+	tbs, err := removeExtension(precert.RawTBSCertificate, PoisonOID)
+	test.AssertNotError(t, err, "Failed to remove poison")
+	test.Assert(t, len(tbs) < len(precert.RawTBSCertificate), "TBSCertificate didn't shrink")
+
+	// The result must be a TBSCertificate with everything but the poison intact
+	var fields []asn1.RawValue
+	_, err = asn1.Unmarshal(tbs, &fields)
+	test.AssertNotError(t, err, "Result isn't a SEQUENCE")
+	var exts []pkix.Extension
+	_, err = asn1.Unmarshal(fields[len(fields)-1].Bytes, &exts)
+	test.AssertNotError(t, err, "Failed to parse extensions")
+	test.AssertEquals(t, len(exts), len(precert.Extensions)-1)
+	for _, ext := range exts {
+		test.Assert(t, !ext.Id.Equal(PoisonOID), "Poison extension still present")
+	}
+
+	_, err = removeExtension(tbs, PoisonOID)
+	test.AssertError(t, err, "Removed missing extension")
+	_, err = removeExtension([]byte{1, 2, 3}, PoisonOID)
+	test.AssertError(t, err, "Accepted malformed TBSCertificate")
+}
+
+func TestSubmit(t *testing.T) {
+	mockLog := test.NewMockCTLog()
+	defer mockLog.Close()
+	log, err := NewLog(LogDescription{URI: mockLog.URL, Key: mockLog.Key}, time.Second)
+	test.AssertNotError(t, err, "Failed to create log")
+
+	precert, issuer := issue(t, []pkix.Extension{PoisonExtension})
+	sct, err := log.SubmitPrecertificate(precert, issuer)
+	test.AssertNotError(t, err, "Failed to submit precertificate")
+	test.AssertEquals(t, sct.LogID, base64.StdEncoding.EncodeToString(log.ID))
+	test.AssertEquals(t, sct.CertificateSerial, core.SerialToString(precert.SerialNumber))
+
+	cert, issuer := issue(t, nil)
+	_, err = log.SubmitCertificate(cert, issuer)
+	test.AssertNotError(t, err, "Failed to submit certificate")
+
+	// SCTs signed by a key other than the configured one are rejected
+	otherLog := test.NewMockCTLog()
+	defer otherLog.Close()
+	log, err = NewLog(LogDescription{URI: otherLog.URL, Key: mockLog.Key}, time.Second)
+	test.AssertNotError(t, err, "Failed to create log")
+	_, err = log.SubmitCertificate(cert, issuer)
+	test.AssertError(t, err, "Accepted SCT from the wrong log")
+
+	_, err = NewLog(LogDescription{URI: mockLog.URL, Key: "not a key"}, time.Second)
+	test.AssertError(t, err, "Created log with an invalid key")
+}
+
+func TestSubmitterPolicy(t *testing.T) {
+	var logs []*test.MockCTLog
+	var config Config
+	for i := 0; i < 3; i++ {
+		mockLog := test.NewMockCTLog()
+		defer mockLog.Close()
+		logs = append(logs, mockLog)
+		config.Logs = append(config.Logs, LogDescription{URI: mockLog.URL, Key: mockLog.Key})
+	}
+
+	submitter, err := NewSubmitter(Config{})
+	test.AssertNotError(t, err, "Failed to create submitter without logs")
+	test.Assert(t, submitter == nil, "Created submitter without logs")
+
+	config.SCTsRequired = 4
+	_, err = NewSubmitter(config)
+	test.AssertError(t, err, "Required more SCTs than there are logs")
+
+	config.SCTsRequired = 2
+	submitter, err = NewSubmitter(config)
+	test.AssertNotError(t, err, "Failed to create submitter")
+
+	precert, issuer := issue(t, []pkix.Extension{PoisonExtension})
+	logs[0].SetFail(true)
+	scts, err := submitter.SubmitPrecertificate(precert.Raw, issuer)
+	test.AssertNotError(t, err, "Failed to collect SCTs with one log down")
+	test.AssertEquals(t, len(scts), 2)
+	test.AssertNotEquals(t, scts[0].LogID, scts[1].LogID)
+
+	logs[1].SetFail(true)
+	_, err = submitter.SubmitPrecertificate(precert.Raw, issuer)
+	test.AssertError(t, err, "Collected SCTs with two logs down")
+}
+
+func TestSCTListExtension(t *testing.T) {
+	logID := make([]byte, 32)
+	logID[0] = 7
+	scts := []core.SignedCertificateTimestamp{
+		core.SignedCertificateTimestamp{
+			LogID:     base64.StdEncoding.EncodeToString(logID),
+			Timestamp: 1234,
+			Signature: []byte{4, 3, 0, 2, 9, 9},
+		},
+		core.SignedCertificateTimestamp{
+			LogID:     base64.StdEncoding.EncodeToString(logID),
+			Timestamp: 5678,
+			Signature: []byte{4, 3, 0, 1, 8},
+		},
+	}
+	ext, err := SCTListExtension(scts)
+	test.AssertNotError(t, err, "Failed to encode SCTs")
+	test.Assert(t, ext.Id.Equal(SCTListOID), "Wrong extension OID")
+	test.Assert(t, !ext.Critical, "SCT list extension is critical")
+
+	var list []byte
+	_, err = asn1.Unmarshal(ext.Value, &list)
+	test.AssertNotError(t, err, "Extension value isn't an OCTET STRING")
+	test.AssertEquals(t, int(binary.BigEndian.Uint16(list)), len(list)-2)
+	// Each SCT: version, log ID, timestamp, empty extensions and signature
+	first := int(binary.BigEndian.Uint16(list[2:]))
+	test.AssertEquals(t, first, 1+32+8+2+6)
+	test.AssertEquals(t, binary.BigEndian.Uint64(list[4+1+32:]), uint64(1234))
+
+	scts[0].LogID = "short"
+	_, err = SCTListExtension(scts)
+	test.AssertError(t, err, "Encoded SCT with invalid log ID")
+}
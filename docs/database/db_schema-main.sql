@@ -28,9 +28,11 @@ CREATE TABLE `authz` (
   `expires` datetime DEFAULT NULL,
   `challenges` varchar(1536) DEFAULT NULL,
   `combinations` varchar(255) DEFAULT NULL,
+  `caaChecked` datetime DEFAULT NULL,
   `sequence` bigint(20) DEFAULT NULL,
   PRIMARY KEY (`id`),
   KEY `regId_idx` (`registrationID`),
+  KEY `expires_idx` (`expires`),
   CONSTRAINT `regId_authz` FOREIGN KEY (`registrationID`) REFERENCES `registrations` (`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
@@ -42,6 +44,7 @@ CREATE TABLE `certificates` (
   `der` mediumblob,
   `issued` datetime DEFAULT NULL,
   `expires` datetime DEFAULT NULL,
+  `profile` varchar(255) DEFAULT NULL,
   PRIMARY KEY (`serial`),
   KEY `regId_certificates_idx` (`registrationID`),
   CONSTRAINT `regId_certificates` FOREIGN KEY (`registrationID`) REFERENCES `registrations` (`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
@@ -59,10 +62,15 @@ CREATE TABLE `certificateStatus` (
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
 CREATE TABLE `crls` (
-  `serial` varchar(255) NOT NULL,
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `issuer` varchar(255) NOT NULL,
+  `number` bigint(20) NOT NULL,
+  `thisUpdate` datetime DEFAULT NULL,
+  `nextUpdate` datetime DEFAULT NULL,
   `createdAt` datetime DEFAULT NULL,
-  `crl` varchar(255) DEFAULT NULL,
-  PRIMARY KEY (`serial`)
+  `crl` mediumblob,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `issuer_number` (`issuer`,`number`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
 CREATE TABLE `deniedCSRs` (
@@ -88,9 +96,40 @@ CREATE TABLE `pending_authz` (
   `expires` datetime DEFAULT NULL,
   `challenges` varchar(1536) DEFAULT NULL,
   `combinations` varchar(255) DEFAULT NULL,
+  `caaChecked` datetime DEFAULT NULL,
   `LockCol` bigint(20) DEFAULT NULL,
   PRIMARY KEY (`id`),
   KEY `regId_idx` (`registrationID`),
+  KEY `expires_idx` (`expires`),
   CONSTRAINT `regId_pending_authz` FOREIGN KEY (`registrationID`) REFERENCES `registrations` (`id`) ON DELETE NO ACTION ON UPDATE NO ACTION
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8;
 
+
+CREATE TABLE `sctReceipts` (
+  `id` int(11) NOT NULL AUTO_INCREMENT,
+  `sctVersion` tinyint(1) NOT NULL,
+  `logID` varchar(255) NOT NULL,
+  `timestamp` bigint(20) NOT NULL,
+  `extensions` blob,
+  `signature` blob,
+  `certificateSerial` varchar(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `certificateSerial_logID` (`certificateSerial`, `logID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `ctSubmissions` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `serial` varchar(255) NOT NULL,
+  `logID` varchar(255) NOT NULL,
+  `status` varchar(255) NOT NULL,
+  `attempts` int(11) NOT NULL,
+  `nextAttempt` datetime NOT NULL,
+  `error` varchar(1024) DEFAULT NULL,
+  `timestamp` bigint(20) DEFAULT NULL,
+  `extensions` blob,
+  `signature` blob,
+  `LockCol` bigint(20) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `serial_logID` (`serial`, `logID`),
+  KEY `status_nextAttempt_idx` (`status`, `nextAttempt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -18,10 +18,12 @@ GRANT SELECT,INSERT,UPDATE ON certificateStatus TO 'sa'@'%';
 GRANT SELECT,INSERT ON deniedCSRs TO 'sa'@'%';
 GRANT INSERT ON ocspResponses TO 'sa'@'%';
 GRANT SELECT,INSERT,UPDATE ON registrations TO 'sa'@'%';
+GRANT SELECT,INSERT ON sctReceipts TO 'sa'@'%';
 
 -- OCSP Responder
 CREATE USER `ocsp_resp`@`%` IDENTIFIED BY 'password';
 GRANT SELECT ON ocspResponses TO 'ocsp_resp'@'%';
+GRANT SELECT ON crls TO 'ocsp_resp'@'%';
 
 -- OCSP Generator Tool (Updater)
 CREATE USER `ocsp_update`@`%` IDENTIFIED BY 'password';
@@ -34,3 +36,19 @@ CREATE USER `revoker`@`%` IDENTIFIED BY 'password';
 GRANT SELECT ON registrations TO 'revoker'@'%';
 GRANT SELECT ON certificates TO 'revoker'@'%';
 GRANT SELECT,INSERT ON deniedCSRs TO 'revoker'@'%';
+
+-- Expired Authorization Purger Tool
+CREATE USER `authz_purger`@`%` IDENTIFIED BY 'password';
+GRANT SELECT,UPDATE,DELETE ON pending_authz TO 'authz_purger'@'%';
+GRANT SELECT,DELETE ON authz TO 'authz_purger'@'%';
+
+-- CT Submitter
+CREATE USER `ct_submitter`@`%` IDENTIFIED BY 'password';
+GRANT SELECT ON certificates TO 'ct_submitter'@'%';
+GRANT SELECT,INSERT,UPDATE ON ctSubmissions TO 'ct_submitter'@'%';
+
+-- CRL Generator
+CREATE USER `crl_generator`@`%` IDENTIFIED BY 'password';
+GRANT SELECT ON certificateStatus TO 'crl_generator'@'%';
+GRANT SELECT ON certificates TO 'crl_generator'@'%';
+GRANT SELECT,INSERT ON crls TO 'crl_generator'@'%';
@@ -0,0 +1,275 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lint checks certificates against the CA/Browser Forum Baseline
+// Requirements. The CA runs the lints on a certificate signed by a throwaway
+// key from the very template it is about to sign, so that a certificate that
+// breaks the requirements is never issued.
+package lint
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/letsencrypt/boulder/policy"
+)
+
+// Level is how serious a lint failure is.
+type Level int
+
+// Warnings are logged; errors prevent issuance.
+const (
+	Warning Level = iota
+	Error
+)
+
+func (level Level) String() string {
+	if level == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// Lint is a single check of a certificate.
+type Lint struct {
+	Name  string
+	Level Level
+	// Check returns an error describing the problem if the certificate fails
+	// the lint.
+	Check func(cert *x509.Certificate) error
+}
+
+// Result is a lint failure.
+type Result struct {
+	Lint    string
+	Level   Level
+	Message string
+}
+
+func (result Result) String() string {
+	return fmt.Sprintf("%s %s: %s", result.Level, result.Lint, result.Message)
+}
+
+// Results are the failures of the lints run on a certificate.
+type Results []Result
+
+// Err returns an error listing the error-level failures, or nil if there
+// are none.
+func (results Results) Err() error {
+	var failures []string
+	for _, result := range results {
+		if result.Level == Error {
+			failures = append(failures, result.String())
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Certificate failed lints: %s", strings.Join(failures, "; "))
+}
+
+// Check runs the lints on a certificate and returns their failures.
+func Check(cert *x509.Certificate, lints []Lint) Results {
+	var results Results
+	for _, lint := range lints {
+		if err := lint.Check(cert); err != nil {
+			results = append(results, Result{Lint: lint.Name, Level: lint.Level, Message: err.Error()})
+		}
+	}
+	return results
+}
+
+// NewLints returns the Baseline Requirements checks for subscriber
+// certificates.  isPublicName decides whether a DNS name ends in a public
+// suffix; the CA passes its policy authority's, so that the lints and the PA
+// agree on which names are public.
+func NewLints(isPublicName func(name string) bool) []Lint {
+	return []Lint{
+		Lint{Name: "validity", Level: Error, Check: checkValidity},
+		Lint{Name: "san_cn", Level: Error, Check: checkSANs},
+		Lint{Name: "key_usage", Level: Error, Check: checkKeyUsage},
+		Lint{Name: "policy_oids", Level: Error, Check: checkPolicies},
+		Lint{Name: "br_policy_oid", Level: Warning, Check: checkBRPolicy},
+		Lint{Name: "aia", Level: Error, Check: checkAIA},
+		Lint{Name: "ca_issuers", Level: Warning, Check: checkCAIssuers},
+		Lint{Name: "crldp", Level: Error, Check: checkCRLDP},
+		Lint{Name: "name_length", Level: Error, Check: checkNameLength},
+		Lint{Name: "reserved_names", Level: Error, Check: reservedNames(isPublicName)},
+	}
+}
+
+// Lints are the Baseline Requirements checks for subscriber certificates,
+// judging names by the compiled-in public suffix list.
+var Lints = NewLints(policy.IsPublicName)
+
+// MaxValidityMonths is the longest validity period the Baseline Requirements
+// allow for a subscriber certificate.
+const MaxValidityMonths = 39
+
+// Upper bounds on name lengths, from RFC 5280 (ub-common-name) and RFC 1035.
+const (
+	maxCommonNameLength = 64
+	maxDNSNameLength    = 253
+	maxLabelLength      = 63
+)
+
+// brPolicyOIDs are the policy identifiers the CA/Browser Forum reserves for
+// asserting compliance with its requirements.
+var brPolicyOIDs = []asn1.ObjectIdentifier{
+	asn1.ObjectIdentifier{2, 23, 140, 1, 1},    // Extended validation
+	asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}, // Domain validated
+	asn1.ObjectIdentifier{2, 23, 140, 1, 2, 2}, // Organization validated
+	asn1.ObjectIdentifier{2, 23, 140, 1, 2, 3}, // Individual validated
+}
+
+func checkValidity(cert *x509.Certificate) error {
+	if !cert.NotAfter.After(cert.NotBefore) {
+		return fmt.Errorf("NotAfter %s is not after NotBefore %s", cert.NotAfter, cert.NotBefore)
+	}
+	if cert.NotAfter.After(cert.NotBefore.AddDate(0, MaxValidityMonths, 0)) {
+		return fmt.Errorf("Validity period %s is longer than %d months", cert.NotAfter.Sub(cert.NotBefore), MaxValidityMonths)
+	}
+	return nil
+}
+
+func checkSANs(cert *x509.Certificate) error {
+	if len(cert.DNSNames) == 0 && len(cert.IPAddresses) == 0 {
+		return fmt.Errorf("No subjectAltName DNS names or IP addresses")
+	}
+	if len(cert.EmailAddresses) > 0 {
+		return fmt.Errorf("Email address subjectAltNames are not allowed")
+	}
+	cn := cert.Subject.CommonName
+	if cn == "" {
+		return nil
+	}
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, cn) {
+			return nil
+		}
+	}
+	for _, ip := range cert.IPAddresses {
+		if ip.String() == cn {
+			return nil
+		}
+	}
+	return fmt.Errorf("CommonName %s is not one of the subjectAltNames", cn)
+}
+
+func checkKeyUsage(cert *x509.Certificate) error {
+	if cert.BasicConstraintsValid && cert.IsCA {
+		return fmt.Errorf("Certificate can sign other certificates")
+	}
+	if cert.KeyUsage&(x509.KeyUsageCertSign|x509.KeyUsageCRLSign) != 0 {
+		return fmt.Errorf("Key usage includes certificate or CRL signing")
+	}
+	serverAuth := false
+	for _, usage := range cert.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			serverAuth = true
+		case x509.ExtKeyUsageClientAuth:
+		default:
+			return fmt.Errorf("Extended key usage %d is not allowed", usage)
+		}
+	}
+	if len(cert.UnknownExtKeyUsage) > 0 {
+		return fmt.Errorf("Extended key usage %s is not allowed", cert.UnknownExtKeyUsage[0])
+	}
+	if !serverAuth {
+		return fmt.Errorf("Extended key usage doesn't include serverAuth")
+	}
+	return nil
+}
+
+func checkPolicies(cert *x509.Certificate) error {
+	if len(cert.PolicyIdentifiers) == 0 {
+		return fmt.Errorf("No certificate policies")
+	}
+	return nil
+}
+
+func checkBRPolicy(cert *x509.Certificate) error {
+	for _, oid := range cert.PolicyIdentifiers {
+		for _, brOID := range brPolicyOIDs {
+			if oid.Equal(brOID) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("No CA/Browser Forum policy identifier")
+}
+
+// checkHTTPURLs requires that each URL in a list is an absolute http URL, as
+// the Baseline Requirements ask for the AIA and CRLDP extensions.
+func checkHTTPURLs(kind string, urls []string) error {
+	for _, u := range urls {
+		parsed, err := url.Parse(u)
+		if err != nil || parsed.Scheme != "http" || parsed.Host == "" {
+			return fmt.Errorf("%s URL %q is not an http URL", kind, u)
+		}
+	}
+	return nil
+}
+
+func checkAIA(cert *x509.Certificate) error {
+	if len(cert.OCSPServer) == 0 {
+		return fmt.Errorf("No OCSP URL in authorityInformationAccess")
+	}
+	if err := checkHTTPURLs("OCSP", cert.OCSPServer); err != nil {
+		return err
+	}
+	return checkHTTPURLs("CA issuers", cert.IssuingCertificateURL)
+}
+
+func checkCAIssuers(cert *x509.Certificate) error {
+	if len(cert.IssuingCertificateURL) == 0 {
+		return fmt.Errorf("No CA issuers URL in authorityInformationAccess")
+	}
+	return nil
+}
+
+func checkCRLDP(cert *x509.Certificate) error {
+	return checkHTTPURLs("CRL distribution point", cert.CRLDistributionPoints)
+}
+
+func checkNameLength(cert *x509.Certificate) error {
+	if len(cert.Subject.CommonName) > maxCommonNameLength {
+		return fmt.Errorf("CommonName is longer than %d characters", maxCommonNameLength)
+	}
+	for _, name := range cert.DNSNames {
+		if len(name) > maxDNSNameLength {
+			return fmt.Errorf("DNS name %s is longer than %d characters", name, maxDNSNameLength)
+		}
+		for _, label := range strings.Split(name, ".") {
+			if len(label) > maxLabelLength {
+				return fmt.Errorf("DNS name %s has a label longer than %d characters", name, maxLabelLength)
+			}
+		}
+	}
+	return nil
+}
+
+// reservedNames returns a check that refuses internal names, which aren't
+// proper subdomains of a public suffix, and reserved IP addresses.  A
+// wildcard name must cover a public name, so "*.co.uk" is refused.
+func reservedNames(isPublicName func(name string) bool) func(cert *x509.Certificate) error {
+	return func(cert *x509.Certificate) error {
+		for _, name := range cert.DNSNames {
+			if !isPublicName(name) {
+				return fmt.Errorf("DNS name %s is not a public name", name)
+			}
+		}
+		for _, ip := range cert.IPAddresses {
+			if policy.IsReservedIP(ip) {
+				return fmt.Errorf("IP address %s is reserved", ip)
+			}
+		}
+		return nil
+	}
+}
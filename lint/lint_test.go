@@ -0,0 +1,221 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lint
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// goodTemplate returns a template for a certificate that passes every lint.
+func goodTemplate() *x509.Certificate {
+	now := time.Now()
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(1234),
+		Subject:               pkix.Name{CommonName: "not-example.com"},
+		DNSNames:              []string{"not-example.com", "www.not-example.com"},
+		NotBefore:             now,
+		NotAfter:              now.Add(90 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		PolicyIdentifiers:     []asn1.ObjectIdentifier{asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}},
+		OCSPServer:            []string{"http://not-example.com/ocsp"},
+		IssuingCertificateURL: []string{"http://not-example.com/issuer"},
+		CRLDistributionPoints: []string{"http://not-example.com/crl"},
+	}
+}
+
+// sign signs a template with a throwaway key and parses the result.
+func sign(t *testing.T, template *x509.Certificate) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	test.AssertNotError(t, err, "Failed to generate key")
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	test.AssertNotError(t, err, "Failed to sign certificate")
+	cert, err := x509.ParseCertificate(der)
+	test.AssertNotError(t, err, "Failed to parse certificate")
+	return cert
+}
+
+// assertLint checks that a lint passes the good template and fails it once
+// modified.
+func assertLint(t *testing.T, name string, modify func(*x509.Certificate)) {
+	var lint Lint
+	for _, l := range Lints {
+		if l.Name == name {
+			lint = l
+		}
+	}
+	test.AssertEquals(t, lint.Name, name)
+
+	test.AssertNotError(t, lint.Check(sign(t, goodTemplate())), "Good certificate failed "+name)
+	template := goodTemplate()
+	modify(template)
+	test.AssertError(t, lint.Check(sign(t, template)), "Bad certificate passed "+name)
+}
+
+func TestCheck(t *testing.T) {
+	results := Check(sign(t, goodTemplate()), Lints)
+	test.AssertEquals(t, len(results), 0)
+	test.AssertNotError(t, results.Err(), "Good certificate has errors")
+
+	template := goodTemplate()
+	template.PolicyIdentifiers = []asn1.ObjectIdentifier{asn1.ObjectIdentifier{1, 2, 3}}
+	results = Check(sign(t, template), Lints)
+	test.AssertEquals(t, len(results), 1)
+	test.AssertEquals(t, results[0].Level, Warning)
+	test.AssertNotError(t, results.Err(), "Warning treated as an error")
+
+	template.CRLDistributionPoints = []string{"ldap://not-example.com/crl"}
+	results = Check(sign(t, template), Lints)
+	test.AssertEquals(t, len(results), 2)
+	err := results.Err()
+	test.AssertError(t, err, "Error-level lint ignored")
+	test.Assert(t, strings.Contains(err.Error(), "crldp"), "Error doesn't name the lint")
+	test.Assert(t, !strings.Contains(err.Error(), "br_policy_oid"), "Error includes warnings")
+}
+
+func TestValidity(t *testing.T) {
+	assertLint(t, "validity", func(cert *x509.Certificate) {
+		cert.NotAfter = cert.NotBefore.AddDate(0, MaxValidityMonths+1, 0)
+	})
+	assertLint(t, "validity", func(cert *x509.Certificate) {
+		cert.NotAfter = cert.NotBefore.Add(-time.Hour)
+	})
+}
+
+func TestSANs(t *testing.T) {
+	assertLint(t, "san_cn", func(cert *x509.Certificate) {
+		cert.Subject.CommonName = "other.not-example.com"
+	})
+	assertLint(t, "san_cn", func(cert *x509.Certificate) {
+		cert.DNSNames = nil
+	})
+	assertLint(t, "san_cn", func(cert *x509.Certificate) {
+		cert.EmailAddresses = []string{"admin@not-example.com"}
+	})
+
+	// A CommonName may instead be one of the IP addresses
+	template := goodTemplate()
+	template.Subject.CommonName = "93.184.216.34"
+	template.IPAddresses = []net.IP{net.ParseIP("93.184.216.34")}
+	test.AssertNotError(t, checkSANs(sign(t, template)), "IP address CommonName rejected")
+}
+
+func TestKeyUsage(t *testing.T) {
+	assertLint(t, "key_usage", func(cert *x509.Certificate) {
+		cert.IsCA = true
+	})
+	assertLint(t, "key_usage", func(cert *x509.Certificate) {
+		cert.KeyUsage |= x509.KeyUsageCertSign
+	})
+	assertLint(t, "key_usage", func(cert *x509.Certificate) {
+		cert.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	})
+	assertLint(t, "key_usage", func(cert *x509.Certificate) {
+		cert.ExtKeyUsage = append(cert.ExtKeyUsage, x509.ExtKeyUsageCodeSigning)
+	})
+}
+
+func TestPolicies(t *testing.T) {
+	assertLint(t, "policy_oids", func(cert *x509.Certificate) {
+		cert.PolicyIdentifiers = nil
+	})
+}
+
+func TestBRPolicy(t *testing.T) {
+	assertLint(t, "br_policy_oid", func(cert *x509.Certificate) {
+		cert.PolicyIdentifiers = []asn1.ObjectIdentifier{asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 44947, 1, 1, 1}}
+	})
+}
+
+func TestAIA(t *testing.T) {
+	assertLint(t, "aia", func(cert *x509.Certificate) {
+		cert.OCSPServer = nil
+	})
+	assertLint(t, "aia", func(cert *x509.Certificate) {
+		cert.OCSPServer = []string{"https://not-example.com/ocsp"}
+	})
+	assertLint(t, "aia", func(cert *x509.Certificate) {
+		cert.IssuingCertificateURL = []string{"not a URL"}
+	})
+}
+
+func TestCAIssuers(t *testing.T) {
+	assertLint(t, "ca_issuers", func(cert *x509.Certificate) {
+		cert.IssuingCertificateURL = nil
+	})
+}
+
+func TestCRLDP(t *testing.T) {
+	assertLint(t, "crldp", func(cert *x509.Certificate) {
+		cert.CRLDistributionPoints = []string{"ldap://not-example.com/crl"}
+	})
+
+	// Certificates don't need a CRL distribution point
+	template := goodTemplate()
+	template.CRLDistributionPoints = nil
+	test.AssertNotError(t, checkCRLDP(sign(t, template)), "Missing CRL distribution point rejected")
+}
+
+func TestNameLength(t *testing.T) {
+	long := strings.Repeat("a", 64) + ".not-example.com"
+	assertLint(t, "name_length", func(cert *x509.Certificate) {
+		cert.Subject.CommonName = strings.Repeat("a", 50) + ".not-example.com"
+	})
+	assertLint(t, "name_length", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, long)
+	})
+	assertLint(t, "name_length", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, strings.Repeat("a.", 127)+"com")
+	})
+}
+
+func TestReservedNames(t *testing.T) {
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, "server.local")
+	})
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, "localhost")
+	})
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, "co.uk")
+	})
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, "*.co.uk")
+	})
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.DNSNames = append(cert.DNSNames, "foo.kawasaki.jp")
+	})
+	assertLint(t, "reserved_names", func(cert *x509.Certificate) {
+		cert.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+	})
+
+	checkReservedNames := reservedNames(policy.IsPublicName)
+	template := goodTemplate()
+	template.DNSNames = append(template.DNSNames, "*.not-example.com", "example.co.uk")
+	template.IPAddresses = []net.IP{net.ParseIP("93.184.216.34")}
+	test.AssertNotError(t, checkReservedNames(sign(t, template)), "Public names rejected")
+
+	// The suffix rules come from the caller
+	onlyExampleCom := reservedNames(func(name string) bool { return strings.HasSuffix(name, "not-example.com") })
+	test.AssertNotError(t, onlyExampleCom(sign(t, goodTemplate())), "Public name rejected")
+	template = goodTemplate()
+	template.DNSNames = append(template.DNSNames, "example.co.uk")
+	test.AssertError(t, onlyExampleCom(sign(t, template)), "Name accepted by default rules")
+}
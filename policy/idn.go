@@ -0,0 +1,150 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package policy
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns/idn"
+)
+
+// Scripts that may legitimately be combined within a single label, following
+// the "Highly Restrictive" profile of Unicode Technical Report #39.  Any label
+// whose letters span more than one script must fit within one of these sets.
+var allowedScriptCombinations = [][]*unicode.RangeTable{
+	{unicode.Latin, unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Latin, unicode.Han, unicode.Bopomofo},
+	{unicode.Latin, unicode.Han, unicode.Hangul},
+}
+
+// Scripts that are checked for mixing.  Characters outside of these (e.g.,
+// digits and the hyphen, which belong to the Common script) are ignored.
+var checkedScripts = []*unicode.RangeTable{
+	unicode.Arabic, unicode.Armenian, unicode.Bengali, unicode.Bopomofo,
+	unicode.Cherokee, unicode.Cyrillic, unicode.Devanagari, unicode.Ethiopic,
+	unicode.Georgian, unicode.Greek, unicode.Gujarati, unicode.Gurmukhi,
+	unicode.Han, unicode.Hangul, unicode.Hebrew, unicode.Hiragana,
+	unicode.Kannada, unicode.Katakana, unicode.Khmer, unicode.Lao,
+	unicode.Latin, unicode.Malayalam, unicode.Mongolian, unicode.Myanmar,
+	unicode.Oriya, unicode.Sinhala, unicode.Tamil, unicode.Telugu,
+	unicode.Thaana, unicode.Thai, unicode.Tibetan,
+}
+
+// decodeALabel converts an A-label (xn--) to its U-label form.  It returns
+// false if the label is not a valid A-label: the punycode must decode, the
+// U-label must contain at least one non-ASCII character, every character must
+// be permitted in an IDNA2008 U-label, the encoding must be canonical (i.e.,
+// re-encoding the U-label yields the original A-label), and the letters must
+// not mix scripts.
+func decodeALabel(label string) (string, bool) {
+	uLabel := idn.FromPunycode(label)
+	if uLabel == label || !utf8.ValidString(uLabel) {
+		return "", false
+	}
+	if idn.ToPunycode(uLabel) != label {
+		return "", false
+	}
+
+	// RFC 5891 Section 4.2.3.1: no hyphens in both the third and fourth
+	// positions, and none at the start or end of the label
+	if strings.HasPrefix(uLabel, "-") || strings.HasSuffix(uLabel, "-") ||
+		(len(uLabel) >= 4 && uLabel[2:4] == "--") {
+		return "", false
+	}
+
+	nonASCII := false
+	for i, r := range uLabel {
+		if r > unicode.MaxASCII {
+			nonASCII = true
+		}
+		if !validULabelRune(r) {
+			return "", false
+		}
+		// RFC 5891 Section 4.2.3.2: a label must not begin with a combining mark
+		if i == 0 && unicode.Is(unicode.M, r) {
+			return "", false
+		}
+	}
+	if !nonASCII {
+		return "", false
+	}
+
+	if mixedScript(uLabel) {
+		return "", false
+	}
+
+	return uLabel, true
+}
+
+// validULabelRune approximates the IDNA2008 PVALID derived property (RFC
+// 5892): lower-case and caseless letters, combining marks, decimal digits, and
+// the hyphen.  Upper-case letters, symbols, punctuation, spaces, and
+// control or formatting characters (including the CONTEXTJ joiners) are
+// refused.
+func validULabelRune(r rune) bool {
+	switch {
+	case r == '-':
+		return true
+	case r <= unicode.MaxASCII:
+		return ('a' <= r && r <= 'z') || ('0' <= r && r <= '9')
+	case unicode.IsUpper(r) || unicode.IsTitle(r):
+		return false
+	case unicode.IsLetter(r), unicode.Is(unicode.M, r), unicode.Is(unicode.Nd, r):
+		return true
+	}
+	return false
+}
+
+// mixedScript returns true if the letters of the label are drawn from more
+// than one script, other than in one of the allowed combinations.
+func mixedScript(label string) bool {
+	var scripts []*unicode.RangeTable
+	for _, r := range label {
+		for _, script := range checkedScripts {
+			if unicode.Is(script, r) {
+				scripts = appendScript(scripts, script)
+				break
+			}
+		}
+	}
+	if len(scripts) <= 1 {
+		return false
+	}
+
+	for _, allowed := range allowedScriptCombinations {
+		if scriptSubset(scripts, allowed) {
+			return false
+		}
+	}
+	return true
+}
+
+func appendScript(scripts []*unicode.RangeTable, script *unicode.RangeTable) []*unicode.RangeTable {
+	for _, s := range scripts {
+		if s == script {
+			return scripts
+		}
+	}
+	return append(scripts, script)
+}
+
+func scriptSubset(scripts, allowed []*unicode.RangeTable) bool {
+	for _, s := range scripts {
+		found := false
+		for _, a := range allowed {
+			if s == a {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// NameList is the on-disk (JSON) format of the name database.
+type NameList struct {
+	// Names that are blocked, along with all of their subdomains
+	Blocked []string `json:"blocked"`
+	// Names that are blocked, but whose subdomains are not
+	ExactBlocked []string `json:"exactBlocked"`
+	// Names that are allowed even if they match a block
+	Allowed []string `json:"allowed"`
+}
+
+// NameDB is a runtime-reloadable set of blocked and allowed names, used by
+// the policy authority in place of the compiled-in blacklist.
+type NameDB struct {
+	sync.RWMutex
+	log  *blog.AuditLogger
+	path string
+
+	blocked      map[string]bool
+	exactBlocked map[string]bool
+	allowed      map[string]bool
+}
+
+// NewNameDB constructs a NameDB from the file at the given path.
+func NewNameDB(path string) (*NameDB, error) {
+	db := &NameDB{
+		log:          blog.GetAuditLogger(),
+		path:         path,
+		blocked:      map[string]bool{},
+		exactBlocked: map[string]bool{},
+		allowed:      map[string]bool{},
+	}
+	if err := db.Reload(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func normalizeNames(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimRight(strings.ToLower(strings.TrimSpace(name)), ".")
+		if name != "" {
+			set[name] = true
+		}
+	}
+	return set
+}
+
+// Reload re-reads the name database from disk.  Every addition and removal is
+// audit-logged.  If the file cannot be read or parsed, the current contents
+// are retained and an error is returned.
+func (db *NameDB) Reload() error {
+	data, err := ioutil.ReadFile(db.path)
+	if err != nil {
+		return err
+	}
+	var list NameList
+	if err = json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("Failed to parse name database %s: %s", db.path, err)
+	}
+	blocked := normalizeNames(list.Blocked)
+	exactBlocked := normalizeNames(list.ExactBlocked)
+	allowed := normalizeNames(list.Allowed)
+
+	db.Lock()
+	defer db.Unlock()
+	db.logChanges("blocked", db.blocked, blocked)
+	db.logChanges("exact-blocked", db.exactBlocked, exactBlocked)
+	db.logChanges("allowed", db.allowed, allowed)
+	db.blocked = blocked
+	db.exactBlocked = exactBlocked
+	db.allowed = allowed
+	return nil
+}
+
+func (db *NameDB) logChanges(listName string, old, new map[string]bool) {
+	var added, removed []string
+	for name := range new {
+		if !old[name] {
+			added = append(added, name)
+		}
+	}
+	for name := range old {
+		if !new[name] {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	for _, name := range added {
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		db.log.Audit(fmt.Sprintf("Name database %s: added %s name %s", db.path, listName, name))
+	}
+	for _, name := range removed {
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		db.log.Audit(fmt.Sprintf("Name database %s: removed %s name %s", db.path, listName, name))
+	}
+}
+
+// IsBlocked returns true if the name, given as one or more label-wise forms
+// (e.g., its A-label and U-label forms), is blocked.  A name on the allow-list
+// in any form is never blocked.
+func (db *NameDB) IsBlocked(forms ...[]string) bool {
+	db.RLock()
+	defer db.RUnlock()
+	for _, labels := range forms {
+		if db.allowed[strings.Join(labels, ".")] {
+			return false
+		}
+	}
+	for _, labels := range forms {
+		if db.exactBlocked[strings.Join(labels, ".")] || suffixMatch(labels, db.blocked, false) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReloadForever reloads the name database whenever the process receives
+// SIGHUP and, if interval is non-zero, every interval.  It does not return.
+func (db *NameDB) ReloadForever(interval time.Duration) {
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGHUP)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		tick = time.Tick(interval)
+	}
+
+	for {
+		select {
+		case <-sigChan:
+			db.log.Notice(fmt.Sprintf("Received SIGHUP, reloading name database %s", db.path))
+		case <-tick:
+		}
+		if err := db.Reload(); err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			db.log.AuditErr(fmt.Errorf("Failed to reload name database: %s", err))
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package policy
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+func writeNameDB(t *testing.T, path, contents string) {
+	err := ioutil.WriteFile(path, []byte(contents), 0600)
+	test.AssertNotError(t, err, "Failed to write name database")
+}
+
+func TestNameDB(t *testing.T) {
+	f, err := ioutil.TempFile("", "name-db")
+	test.AssertNotError(t, err, "Failed to create temporary file")
+	f.Close()
+	defer os.Remove(f.Name())
+
+	writeNameDB(t, f.Name(), `{
+		"blocked": ["Phish.com", "bad.example.net."],
+		"exactBlocked": ["www.example.org"],
+		"allowed": ["ok.phish.com"]
+	}`)
+	db, err := NewNameDB(f.Name())
+	test.AssertNotError(t, err, "Failed to load name database")
+
+	blocked := func(name string) bool {
+		return db.IsBlocked(strings.Split(name, "."))
+	}
+	test.Assert(t, blocked("phish.com"), "Blocked name was allowed")
+	test.Assert(t, blocked("www.phish.com"), "Subdomain of blocked name was allowed")
+	test.Assert(t, blocked("bad.example.net"), "Blocked name was allowed")
+	test.Assert(t, !blocked("example.net"), "Parent of blocked name was blocked")
+	test.Assert(t, blocked("www.example.org"), "Exact-blocked name was allowed")
+	test.Assert(t, !blocked("a.www.example.org"), "Subdomain of exact-blocked name was blocked")
+	test.Assert(t, !blocked("ok.phish.com"), "Allowed name was blocked")
+
+	// Unparseable updates are refused and the current contents retained
+	writeNameDB(t, f.Name(), `{"blocked": [`)
+	test.AssertError(t, db.Reload(), "Loaded a malformed name database")
+	test.Assert(t, blocked("phish.com"), "Malformed reload dropped blocked names")
+
+	writeNameDB(t, f.Name(), `{"blocked": ["example.org"]}`)
+	test.AssertNotError(t, db.Reload(), "Failed to reload name database")
+	test.Assert(t, !blocked("phish.com"), "Removed name is still blocked")
+	test.Assert(t, blocked("www.example.org"), "Added name was not blocked")
+
+	_, err = NewNameDB(f.Name() + ".missing")
+	test.AssertError(t, err, "Loaded a missing name database")
+}
+
+func TestWillingToIssueNameDB(t *testing.T) {
+	f, err := ioutil.TempFile("", "name-db")
+	test.AssertNotError(t, err, "Failed to create temporary file")
+	f.Close()
+	defer os.Remove(f.Name())
+
+	writeNameDB(t, f.Name(), `{
+		"blocked": ["phish.com", "bücher.com"],
+		"allowed": ["www.google.com"]
+	}`)
+	db, err := NewNameDB(f.Name())
+	test.AssertNotError(t, err, "Failed to load name database")
+
+	pa := NewPolicyAuthorityImpl()
+	pa.NameDB = db
+
+	for _, name := range []string{"login.phish.com", "www.xn--bcher-kva.com"} {
+		err = pa.WillingToIssue(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: name})
+		if _, ok := err.(BlacklistedError); !ok {
+			t.Error("Identifier was not correctly forbidden: ", name, err)
+		}
+	}
+
+	// The compiled-in blacklist is not consulted when a NameDB is configured
+	err = pa.WillingToIssue(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "www.google.com"})
+	test.AssertNotError(t, err, "Allowed name was forbidden")
+}
@@ -18,8 +18,13 @@ import (
 type PolicyAuthorityImpl struct {
 	log *blog.AuditLogger
 
-	PublicSuffixList map[string]bool // A copy of the DNS root zone
-	Blacklist        map[string]bool // A blacklist of denied names
+	Blacklist map[string]bool // A blacklist of denied names
+
+	// If set, NameDB is consulted instead of Blacklist
+	NameDB *NameDB
+	// If set, SuffixDB is consulted instead of the compiled-in public suffix
+	// rules
+	SuffixDB *PublicSuffixDB
 }
 
 // NewPolicyAuthorityImpl constructs a Policy Authority.
@@ -30,7 +35,6 @@ func NewPolicyAuthorityImpl() *PolicyAuthorityImpl {
 	pa := PolicyAuthorityImpl{log: logger}
 
 	// TODO: Add configurability
-	pa.PublicSuffixList = PublicSuffixList
 	pa.Blacklist = blacklist
 
 	return &pa
@@ -62,6 +66,60 @@ func suffixMatch(labels []string, suffixSet map[string]bool, properSuffix bool)
 	return false
 }
 
+// ReservedNetworks lists the IANA special-purpose address blocks (RFC 6890
+// and friends) that are not reachable on the public Internet.  It's also the
+// VA's default list of addresses that validation never connects to.
+var ReservedNetworks = mustParseCIDRs([]string{
+	"0.0.0.0/8",       // "This" network
+	"10.0.0.0/8",      // Private-Use
+	"100.64.0.0/10",   // Shared Address Space
+	"127.0.0.0/8",     // Loopback
+	"169.254.0.0/16",  // Link Local
+	"172.16.0.0/12",   // Private-Use
+	"192.0.0.0/24",    // IETF Protocol Assignments
+	"192.0.2.0/24",    // Documentation (TEST-NET-1)
+	"192.88.99.0/24",  // 6to4 Relay Anycast
+	"192.168.0.0/16",  // Private-Use
+	"198.18.0.0/15",   // Benchmarking
+	"198.51.100.0/24", // Documentation (TEST-NET-2)
+	"203.0.113.0/24",  // Documentation (TEST-NET-3)
+	"224.0.0.0/4",     // Multicast
+	"240.0.0.0/4",     // Reserved, including limited broadcast
+	"::/128",          // Unspecified Address
+	"::1/128",         // Loopback Address
+	"64:ff9b::/96",    // IPv4-IPv6 Translation
+	"100::/64",        // Discard-Only Address Block
+	"2001::/23",       // IETF Protocol Assignments
+	"2001:db8::/32",   // Documentation
+	"2002::/16",       // 6to4
+	"fc00::/7",        // Unique-Local
+	"fe80::/10",       // Link-Scoped Unicast
+	"ff00::/8",        // Multicast
+})
+
+func mustParseCIDRs(cidrs []string) []*net.IPNet {
+	networks := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks[i] = network
+	}
+	return networks
+}
+
+// IsReservedIP returns true if the address falls within one of the
+// special-purpose address blocks that are not publicly routable.
+func IsReservedIP(ip net.IP) bool {
+	for _, network := range ReservedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // InvalidIdentifierError indicates that we didn't understand the IdentifierType
 // provided.
 type InvalidIdentifierError struct{}
@@ -84,7 +142,7 @@ func (e BlacklistedError) Error() string       { return "Name is blacklisted" }
 // WillingToIssue determines whether the CA is willing to issue for the provided
 // identifier.
 //
-// We place several criteria on identifiers we are willing to issue for:
+// We place several criteria on DNS identifiers we are willing to issue for:
 //
 //  * MUST self-identify as DNS identifiers
 //  * MUST contain only bytes in the DNS hostname character set
@@ -92,12 +150,19 @@ func (e BlacklistedError) Error() string       { return "Name is blacklisted" }
 //  * MUST follow the DNS hostname syntax rules in RFC 1035 and RFC 2181
 //    In particular:
 //    * MUST NOT contain underscores
-//  * MUST NOT contain IDN labels (xn--)
+//  * MUST NOT contain IDN labels (xn--) other than valid IDNA2008 A-labels
+//    whose letters are drawn from a single script (see decodeALabel)
 //  * MUST NOT match the syntax of an IP address
 //  * MUST end in a public suffix
 //  * MUST have at least one label in addition to the public suffix
 //  * MUST NOT be a label-wise suffix match for a name on the black list,
 //    where comparison is case-independent (normalized to lower case)
+//    (if a NameDB is configured, its blocked and allowed names are used)
+//
+// The public suffix and black list checks are applied to both the A-label
+// and U-label forms of the name.
+//
+// IP identifiers are handled by willingToIssueIP.
 //
 // XXX: Is there any need for this method to be constant-time?  We're
 //      going to refuse to issue anyway, but timing could leak whether
@@ -105,7 +170,11 @@ func (e BlacklistedError) Error() string       { return "Name is blacklisted" }
 //
 // XXX: We should probably fold everything to lower-case somehow.
 func (pa PolicyAuthorityImpl) WillingToIssue(id core.AcmeIdentifier) error {
-	if id.Type != core.IdentifierDNS {
+	switch id.Type {
+	case core.IdentifierDNS:
+	case core.IdentifierIP:
+		return pa.willingToIssueIP(id.Value)
+	default:
 		return InvalidIdentifierError{}
 	}
 	domain := id.Value
@@ -129,7 +198,8 @@ func (pa PolicyAuthorityImpl) WillingToIssue(id core.AcmeIdentifier) error {
 	if len(labels) > maxLabels || len(labels) < 2 {
 		return SyntaxError{}
 	}
-	for _, label := range labels {
+	uLabels := make([]string, len(labels))
+	for i, label := range labels {
 		// DNS defines max label length as 63 characters. Some implementations allow
 		// more, but we will be conservative.
 		if len(label) < 1 || len(label) > 63 {
@@ -140,29 +210,114 @@ func (pa PolicyAuthorityImpl) WillingToIssue(id core.AcmeIdentifier) error {
 			return SyntaxError{}
 		}
 
+		uLabels[i] = label
 		if punycodeRegexp.MatchString(label) {
-			return SyntaxError{}
+			uLabel, ok := decodeALabel(label)
+			if !ok {
+				return SyntaxError{}
+			}
+			uLabels[i] = uLabel
 		}
 	}
 
 	// Require match to PSL, plus at least one label
-	if !suffixMatch(labels, pa.PublicSuffixList, true) {
+	if !pa.hasPublicParent(labels, uLabels) {
 		return NonPublicError{}
 	}
 
 	// Require no match against blacklist
-	if suffixMatch(labels, pa.Blacklist, false) {
+	if pa.NameDB != nil {
+		if pa.NameDB.IsBlocked(labels, uLabels) {
+			return BlacklistedError{}
+		}
+	} else if suffixMatch(labels, pa.Blacklist, false) ||
+		suffixMatch(uLabels, pa.Blacklist, false) {
 		return BlacklistedError{}
 	}
 
 	return nil
 }
 
+// hasPublicParent returns true if the name, given as its A-labels and
+// U-labels, is a proper subdomain of a public suffix, including the list's
+// wildcard and exception rules.
+func (pa PolicyAuthorityImpl) hasPublicParent(labels, uLabels []string) bool {
+	psl := compiledSuffixRules
+	if pa.SuffixDB != nil {
+		psl = pa.SuffixDB.Rules()
+	}
+	return psl.hasPublicParent(labels) || psl.hasPublicParent(uLabels)
+}
+
+// IsPublicName returns true if the name is a proper subdomain of a public
+// suffix, by the same rules WillingToIssue applies.  A wildcard name is
+// judged by its base domain, so "*.co.uk" is not a public name.
+func (pa PolicyAuthorityImpl) IsPublicName(name string) bool {
+	name = strings.TrimSuffix(strings.ToLower(strings.TrimPrefix(name, "*.")), ".")
+	labels := strings.Split(name, ".")
+	uLabels := make([]string, len(labels))
+	for i, label := range labels {
+		uLabels[i] = label
+		if punycodeRegexp.MatchString(label) {
+			if uLabel, ok := decodeALabel(label); ok {
+				uLabels[i] = uLabel
+			}
+		}
+	}
+	return pa.hasPublicParent(labels, uLabels)
+}
+
+// IsPublicName returns true if the name is a proper subdomain of a public
+// suffix by the compiled-in rules.  Use the method of the same name on
+// PolicyAuthorityImpl to respect a configured list.
+func IsPublicName(name string) bool {
+	return PolicyAuthorityImpl{}.IsPublicName(name)
+}
+
+// RegisteredDomain returns the registered domain (the "eTLD+1") of the name,
+// using the configured public suffix list if there is one.
+func (pa PolicyAuthorityImpl) RegisteredDomain(name string) (string, error) {
+	if pa.SuffixDB != nil {
+		return pa.SuffixDB.RegisteredDomain(name)
+	}
+	return RegisteredDomain(name)
+}
+
+// willingToIssueIP determines whether the CA is willing to issue for an IP
+// address identifier.  The address MUST be in canonical textual form (as
+// produced by net.IP.String) and MUST NOT fall within a reserved range.
+func (pa PolicyAuthorityImpl) willingToIssueIP(address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil || ip.String() != address {
+		return SyntaxError{}
+	}
+
+	if IsReservedIP(ip) {
+		return NonPublicError{}
+	}
+
+	return nil
+}
+
 // ChallengesFor makes a decision of what challenges, and combinations, are
 // acceptable for the given identifier.
 //
 // Note: Current implementation is static, but future versions may not be.
 func (pa PolicyAuthorityImpl) ChallengesFor(identifier core.AcmeIdentifier) (challenges []core.Challenge, combinations [][]int) {
+	// There is no DNS zone in which to provision a challenge record for an IP
+	// address, so only the challenges that connect to the address are offered.
+	if identifier.Type == core.IdentifierIP {
+		challenges = []core.Challenge{
+			core.SimpleHTTPChallenge(),
+			core.DvsniChallenge(),
+		}
+		combinations = [][]int{
+			[]int{0},
+			[]int{1},
+		}
+		return
+	}
+
 	challenges = []core.Challenge{
 		core.SimpleHTTPChallenge(),
 		core.DvsniChallenge(),
@@ -29,9 +29,12 @@ func TestWillingToIssue(t *testing.T) {
 
 		`www.abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz.com`, // Label too long (>63 characters)
 
-		`www.-ombo.com`,   // Label starts with '-'
-		`www.xn--hmr.net`, // Punycode (disallowed for now)
-		`xn--.net`,        // No punycode for now.
+		`www.-ombo.com`,    // Label starts with '-'
+		`xn--.net`,         // Empty punycode
+		`xn--ls8h.com`,     // Symbol (emoji)
+		`xn--zombo-.com`,   // Punycode with no encoded characters
+		`xn--pple-43d.com`, // Mixed Latin and Cyrillic
+		`xn--a-ecp.com`,    // Upper-case U-label
 		`0`,
 		`1`,
 		`*`,
@@ -67,6 +70,7 @@ func TestWillingToIssue(t *testing.T) {
 		`example.internal`,
 		// All-numeric final label not okay.
 		`www.zombo.163`,
+		`xn--bcher-kva.xn--p1ai`,
 	}
 
 	shouldBeBlacklisted := []string{
@@ -85,12 +89,17 @@ func TestWillingToIssue(t *testing.T) {
 		"zombo-.com",
 		"www.zom-bo.com",
 		"www.zombo-.com",
+		"www.xn--hmr.net",        // Han
+		"xn--bcher-kva.com",      // Latin (bücher)
+		"xn--e1afmkfd.com",       // Cyrillic (пример)
+		"xn--r8jz45g.jp",         // Han and Hiragana (例え)
+		"xn--hxajbheg2az3al.com", // Greek (παράδειγμα)
 	}
 
 	pa := NewPolicyAuthorityImpl()
 
 	// Test for invalid identifier type
-	identifier := core.AcmeIdentifier{Type: "ip", Value: "example.com"}
+	identifier := core.AcmeIdentifier{Type: "iris", Value: "example.com"}
 	err := pa.WillingToIssue(identifier)
 	_, ok := err.(InvalidIdentifierError)
 	if !ok {
@@ -136,6 +145,67 @@ func TestWillingToIssue(t *testing.T) {
 	}
 }
 
+func TestWillingToIssueIP(t *testing.T) {
+	shouldBeSyntaxError := []string{
+		``,
+		`example.com`,
+		`1.2.3`,
+		`1.2.3.256`,
+		`01.2.3.4`,
+		`[2606:4700::1]`,
+		`2606:4700:0:0:0:0:0:1`, // Non-canonical form
+		`2606:4700::1%eth0`,
+		`8.8.8.8:53`,
+		`2001:db8::/32`,
+	}
+
+	shouldBeNonPublic := []string{
+		`0.0.0.0`,
+		`10.1.2.3`,
+		`127.0.0.1`,
+		`169.254.169.254`,
+		`172.16.0.1`,
+		`192.168.1.1`,
+		`192.0.2.1`,
+		`224.0.0.1`,
+		`255.255.255.255`,
+		`::1`,
+		`2001:db8::1`,
+		`fc00::1`,
+		`fe80::1:1`,
+	}
+
+	shouldBeAccepted := []string{
+		`8.8.8.8`,
+		`93.184.216.34`,
+		`2606:4700::1`,
+		`2001:4860:4860::8888`,
+	}
+
+	pa := NewPolicyAuthorityImpl()
+
+	for _, address := range shouldBeSyntaxError {
+		identifier := core.AcmeIdentifier{Type: core.IdentifierIP, Value: address}
+		if _, ok := pa.WillingToIssue(identifier).(SyntaxError); !ok {
+			t.Error("Identifier was not correctly forbidden: ", identifier)
+		}
+	}
+
+	for _, address := range shouldBeNonPublic {
+		identifier := core.AcmeIdentifier{Type: core.IdentifierIP, Value: address}
+		if _, ok := pa.WillingToIssue(identifier).(NonPublicError); !ok {
+			t.Error("Identifier was not correctly forbidden: ", identifier)
+		}
+	}
+
+	for _, address := range shouldBeAccepted {
+		identifier := core.AcmeIdentifier{Type: core.IdentifierIP, Value: address}
+		if err := pa.WillingToIssue(identifier); err != nil {
+			t.Error("Identifier was incorrectly forbidden: ", identifier, err)
+		}
+	}
+}
+
 func TestChallengesFor(t *testing.T) {
 	pa := NewPolicyAuthorityImpl()
 
@@ -150,3 +220,17 @@ func TestChallengesFor(t *testing.T) {
 		t.Error("Incorrect combinations returned")
 	}
 }
+
+func TestChallengesForIP(t *testing.T) {
+	pa := NewPolicyAuthorityImpl()
+
+	challenges, combinations := pa.ChallengesFor(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "8.8.8.8"})
+
+	if len(challenges) != 2 || challenges[0].Type != core.ChallengeTypeSimpleHTTP ||
+		challenges[1].Type != core.ChallengeTypeDVSNI {
+		t.Error("Incorrect challenges returned")
+	}
+	if len(combinations) != 2 || combinations[0][0] != 0 || combinations[1][0] != 1 {
+		t.Error("Incorrect combinations returned")
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package policy
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns/idn"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// PublicSuffixRules is a parsed copy of the Public Suffix List, in the format
+// of https://publicsuffix.org/list/public_suffix_list.dat.  It implements the
+// wildcard ("*.ck") and exception ("!www.ck") rules that the compiled-in
+// PublicSuffixList map leaves out, and PublicSuffixWildcards and
+// PublicSuffixExceptions hold instead.
+type PublicSuffixRules struct {
+	rules      map[string]bool // "com"
+	wildcards  map[string]bool // "*.ck" is stored as "ck"
+	exceptions map[string]bool // "!www.ck" is stored as "www.ck"
+}
+
+// ParsePublicSuffixRules parses a list in the upstream public_suffix_list.dat
+// format.  Rules in Unicode are also indexed by their A-label form.
+func ParsePublicSuffixRules(r io.Reader) (*PublicSuffixRules, error) {
+	psl := &PublicSuffixRules{
+		rules:      map[string]bool{},
+		wildcards:  map[string]bool{},
+		exceptions: map[string]bool{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		// Each line is only read up to the first whitespace
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "//") {
+			continue
+		}
+		rule := strings.ToLower(fields[0])
+
+		set := psl.rules
+		switch {
+		case strings.HasPrefix(rule, "!"):
+			rule = rule[1:]
+			set = psl.exceptions
+			if !strings.Contains(rule, ".") {
+				return nil, fmt.Errorf("line %d: exception rule %q has only one label", lineNum, fields[0])
+			}
+		case strings.HasPrefix(rule, "*."):
+			rule = rule[2:]
+			set = psl.wildcards
+		}
+		if rule == "" || strings.Contains(rule, "*") || strings.Contains(rule, "!") ||
+			strings.HasPrefix(rule, ".") || strings.HasSuffix(rule, ".") || strings.Contains(rule, "..") {
+			return nil, fmt.Errorf("line %d: malformed rule %q", lineNum, fields[0])
+		}
+
+		set[rule] = true
+		if aLabel := idn.ToPunycode(rule); aLabel != rule {
+			set[aLabel] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if psl.Len() == 0 {
+		return nil, fmt.Errorf("no rules found")
+	}
+	return psl, nil
+}
+
+// Len returns the number of rules in the list, counting rules in Unicode and
+// their A-label forms separately.
+func (psl *PublicSuffixRules) Len() int {
+	return len(psl.rules) + len(psl.wildcards) + len(psl.exceptions)
+}
+
+// Rules returns every rule in the list in its textual form.
+func (psl *PublicSuffixRules) Rules() []string {
+	rules := make([]string, 0, psl.Len())
+	for rule := range psl.rules {
+		rules = append(rules, rule)
+	}
+	for rule := range psl.wildcards {
+		rules = append(rules, "*."+rule)
+	}
+	for rule := range psl.exceptions {
+		rules = append(rules, "!"+rule)
+	}
+	return rules
+}
+
+// publicSuffix returns the number of trailing labels that make up the public
+// suffix of the name, following the algorithm at https://publicsuffix.org/list/.
+// If no rule matches, the implicit "*" rule applies and explicit is false.
+func (psl *PublicSuffixRules) publicSuffix(labels []string) (suffixLabels int, explicit bool) {
+	for i := range labels {
+		candidate := strings.Join(labels[i:], ".")
+		// An exception rule takes priority, and removes its leftmost label
+		if psl.exceptions[candidate] {
+			return len(labels) - i - 1, true
+		}
+		// A wildcard matches one more label than its own
+		if i > 0 && psl.wildcards[candidate] {
+			return len(labels) - i + 1, true
+		}
+		if psl.rules[candidate] {
+			return len(labels) - i, true
+		}
+	}
+	return 1, false
+}
+
+// PublicSuffix returns the public suffix of the domain, and whether it was
+// matched by an explicit rule in the list rather than the implicit "*" rule.
+func (psl *PublicSuffixRules) PublicSuffix(domain string) (suffix string, explicit bool) {
+	labels := strings.Split(strings.TrimSuffix(strings.ToLower(domain), "."), ".")
+	n, explicit := psl.publicSuffix(labels)
+	return strings.Join(labels[len(labels)-n:], "."), explicit
+}
+
+// hasPublicParent returns true if the name is a proper subdomain of a public
+// suffix that is listed explicitly.
+func (psl *PublicSuffixRules) hasPublicParent(labels []string) bool {
+	n, explicit := psl.publicSuffix(labels)
+	return explicit && len(labels) > n
+}
+
+// RegisteredDomain returns the registered domain (the "eTLD+1") of the name:
+// its public suffix plus one more label.  If the name is itself a public
+// suffix, NonPublicError is returned; if it is malformed, SyntaxError.
+func (psl *PublicSuffixRules) RegisteredDomain(name string) (string, error) {
+	name = strings.ToLower(name)
+	labels := strings.Split(strings.TrimSuffix(name, "."), ".")
+	for _, label := range labels {
+		if label == "" {
+			return "", SyntaxError{}
+		}
+	}
+
+	n, _ := psl.publicSuffix(labels)
+	if len(labels) <= n {
+		return "", NonPublicError{}
+	}
+	return strings.Join(labels[len(labels)-n-1:], "."), nil
+}
+
+// compiledSuffixRules wraps the compiled-in PublicSuffixList, along with its
+// wildcard and exception rules.
+var compiledSuffixRules = &PublicSuffixRules{
+	rules:      PublicSuffixList,
+	wildcards:  PublicSuffixWildcards,
+	exceptions: PublicSuffixExceptions,
+}
+
+// RegisteredDomain returns the registered domain (the "eTLD+1") of the name
+// according to the compiled-in public suffix list.  Use the method of the same
+// name on PolicyAuthorityImpl to respect a configured list.
+func RegisteredDomain(name string) (string, error) {
+	return compiledSuffixRules.RegisteredDomain(name)
+}
+
+// PublicSuffixDB holds PublicSuffixRules loaded from a file, which can be
+// replaced at runtime when the file changes.
+type PublicSuffixDB struct {
+	sync.RWMutex
+	log     *blog.AuditLogger
+	path    string
+	modTime time.Time
+	rules   *PublicSuffixRules
+}
+
+// NewPublicSuffixDB loads the public suffix list at the given path.
+func NewPublicSuffixDB(path string) (*PublicSuffixDB, error) {
+	db := &PublicSuffixDB{
+		log:  blog.GetAuditLogger(),
+		path: path,
+	}
+	if _, err := db.ReloadIfChanged(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// Rules returns the current set of rules.
+func (db *PublicSuffixDB) Rules() *PublicSuffixRules {
+	db.RLock()
+	defer db.RUnlock()
+	return db.rules
+}
+
+// RegisteredDomain returns the registered domain (the "eTLD+1") of the name
+// according to the current set of rules.
+func (db *PublicSuffixDB) RegisteredDomain(name string) (string, error) {
+	return db.Rules().RegisteredDomain(name)
+}
+
+// ReloadIfChanged re-reads the list if the file's modification time has
+// changed since it was last loaded.  A list that fails to parse is not
+// swapped in.
+func (db *PublicSuffixDB) ReloadIfChanged() (reloaded bool, err error) {
+	file, err := os.Open(db.path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return
+	}
+
+	db.RLock()
+	unchanged := db.rules != nil && info.ModTime().Equal(db.modTime)
+	db.RUnlock()
+	if unchanged {
+		return
+	}
+
+	rules, err := ParsePublicSuffixRules(file)
+	if err != nil {
+		err = fmt.Errorf("Failed to parse public suffix list %s: %s", db.path, err)
+		return
+	}
+
+	db.Lock()
+	db.rules = rules
+	db.modTime = info.ModTime()
+	db.Unlock()
+
+	// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+	db.log.Audit(fmt.Sprintf("Loaded public suffix list %s with %d rules (modified %s)", db.path, rules.Len(), info.ModTime()))
+	return true, nil
+}
+
+// ReloadForever checks the list for changes every interval.  It does not
+// return.
+func (db *PublicSuffixDB) ReloadForever(interval time.Duration) {
+	for _ = range time.Tick(interval) {
+		if _, err := db.ReloadIfChanged(); err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			db.log.AuditErr(err)
+		}
+	}
+}
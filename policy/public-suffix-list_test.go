@@ -0,0 +1,334 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package policy
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+const testPSL = `// This is a comment.
+
+// ===BEGIN ICANN DOMAINS===
+com
+uk
+co.uk
+jp
+kawasaki.jp
+*.kawasaki.jp
+!city.kawasaki.jp
+*.ck
+!www.ck
+рф
+// ===END ICANN DOMAINS===
+// ===BEGIN PRIVATE DOMAINS===
+appspot.com	trailing text is ignored
+// ===END PRIVATE DOMAINS===
+`
+
+func TestParsePublicSuffixRules(t *testing.T) {
+	psl, err := ParsePublicSuffixRules(strings.NewReader(testPSL))
+	test.AssertNotError(t, err, "Failed to parse public suffix list")
+
+	type suffixTest struct {
+		domain   string
+		suffix   string
+		explicit bool
+	}
+	tests := []suffixTest{
+		{"example.com", "com", true},
+		{"www.example.com", "com", true},
+		{"example.appspot.com", "appspot.com", true},
+		{"example.co.uk", "co.uk", true},
+		{"example.uk", "uk", true},
+		{"kawasaki.jp", "kawasaki.jp", true},
+		{"foo.kawasaki.jp", "foo.kawasaki.jp", true},
+		{"www.foo.kawasaki.jp", "foo.kawasaki.jp", true},
+		{"city.kawasaki.jp", "kawasaki.jp", true},
+		{"www.city.kawasaki.jp", "kawasaki.jp", true},
+		{"ck", "ck", false},
+		{"test.ck", "test.ck", true},
+		{"b.test.ck", "test.ck", true},
+		{"www.ck", "ck", true},
+		{"www.www.ck", "ck", true},
+		{"example.xn--p1ai", "xn--p1ai", true},
+		{"example.рф", "рф", true},
+		{"example.invalid", "invalid", false},
+		{"Example.COM.", "com", true},
+	}
+	for _, st := range tests {
+		suffix, explicit := psl.PublicSuffix(st.domain)
+		test.AssertEquals(t, suffix, st.suffix)
+		test.AssertEquals(t, explicit, st.explicit)
+	}
+
+	malformed := []string{
+		"",
+		"// only comments\n",
+		"!com\n",
+		"*.*.com\n",
+		"foo.*.com\n",
+		"com.\n",
+		".com\n",
+		"foo..com\n",
+	}
+	for _, list := range malformed {
+		_, err = ParsePublicSuffixRules(strings.NewReader(list))
+		test.AssertError(t, err, list)
+	}
+}
+
+func TestPublicSuffixDB(t *testing.T) {
+	f, err := ioutil.TempFile("", "psl")
+	test.AssertNotError(t, err, "Failed to create temporary file")
+	f.Close()
+	defer os.Remove(f.Name())
+
+	err = ioutil.WriteFile(f.Name(), []byte(testPSL), 0600)
+	test.AssertNotError(t, err, "Failed to write public suffix list")
+	db, err := NewPublicSuffixDB(f.Name())
+	test.AssertNotError(t, err, "Failed to load public suffix list")
+
+	pa := NewPolicyAuthorityImpl()
+	pa.SuffixDB = db
+
+	willingToIssue := func(name string) error {
+		return pa.WillingToIssue(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: name})
+	}
+	test.AssertNotError(t, willingToIssue("zombo.com"), "Refused a registered domain")
+	test.AssertNotError(t, willingToIssue("www.city.kawasaki.jp"), "Refused an exception rule")
+	test.AssertNotError(t, willingToIssue("zombo.xn--p1ai"), "Refused a Unicode suffix in A-label form")
+	test.AssertNotError(t, willingToIssue("www.ck"), "Refused an exception rule")
+	for _, name := range []string{"co.uk", "foo.kawasaki.jp", "test.ck", "zombo.net"} {
+		if _, ok := willingToIssue(name).(NonPublicError); !ok {
+			t.Error("Identifier was not correctly forbidden: ", name)
+		}
+	}
+
+	// IsPublicName applies the same rules, judging wildcards by their base
+	for _, name := range []string{"zombo.com", "*.zombo.com", "www.city.kawasaki.jp", "zombo.xn--p1ai", "ZOMBO.com."} {
+		test.Assert(t, pa.IsPublicName(name), "Not a public name: "+name)
+	}
+	for _, name := range []string{"co.uk", "*.co.uk", "*.kawasaki.jp", "foo.kawasaki.jp", "zombo.net"} {
+		test.Assert(t, !pa.IsPublicName(name), "Public name: "+name)
+	}
+	test.Assert(t, IsPublicName("zombo.net"), "Compiled-in list not used")
+	test.Assert(t, !IsPublicName("*.co.uk"), "Wildcard for a public suffix is a public name")
+
+	reloaded, err := db.ReloadIfChanged()
+	test.AssertNotError(t, err, "Failed to check public suffix list")
+	test.Assert(t, !reloaded, "Reloaded an unchanged public suffix list")
+
+	// A malformed list is not swapped in
+	err = ioutil.WriteFile(f.Name(), []byte("foo..com\n"), 0600)
+	test.AssertNotError(t, err, "Failed to write public suffix list")
+	os.Chtimes(f.Name(), time.Now(), time.Now().Add(time.Minute))
+	_, err = db.ReloadIfChanged()
+	test.AssertError(t, err, "Loaded a malformed public suffix list")
+	test.AssertNotError(t, willingToIssue("zombo.com"), "Malformed list was swapped in")
+
+	err = ioutil.WriteFile(f.Name(), []byte("net\n"), 0600)
+	test.AssertNotError(t, err, "Failed to write public suffix list")
+	os.Chtimes(f.Name(), time.Now(), time.Now().Add(2*time.Minute))
+	reloaded, err = db.ReloadIfChanged()
+	test.AssertNotError(t, err, "Failed to reload public suffix list")
+	test.Assert(t, reloaded, "Did not reload a changed public suffix list")
+	test.AssertNotError(t, willingToIssue("zombo.net"), "Refused a newly-listed suffix")
+	if _, ok := willingToIssue("zombo.com").(NonPublicError); !ok {
+		t.Error("Identifier was not correctly forbidden after reload")
+	}
+}
+
+// The subset of the upstream list exercised by the upstream test vectors
+// (https://raw.githubusercontent.com/publicsuffix/list/master/tests/test_psl.txt)
+const vectorPSL = `
+com
+biz
+uk.com
+ac
+*.mm
+jp
+ac.jp
+kyoto.jp
+ide.kyoto.jp
+*.kobe.jp
+!city.kobe.jp
+*.ck
+!www.ck
+us
+ak.us
+k12.ak.us
+cn
+com.cn
+公司.cn
+中国
+`
+
+func TestRegisteredDomain(t *testing.T) {
+	psl, err := ParsePublicSuffixRules(strings.NewReader(vectorPSL))
+	test.AssertNotError(t, err, "Failed to parse public suffix list")
+
+	// An empty result means the name has no registered domain.
+	vectors := []struct {
+		name       string
+		registered string
+	}{
+		// Mixed case.
+		{"COM", ""},
+		{"example.COM", "example.com"},
+		{"WwW.example.COM", "example.com"},
+		// Leading dot.
+		{".com", ""},
+		{".example", ""},
+		{".example.com", ""},
+		{".example.example", ""},
+		// Unlisted TLD.
+		{"example", ""},
+		{"example.example", "example.example"},
+		{"b.example.example", "example.example"},
+		{"a.b.example.example", "example.example"},
+		// TLD with only 1 rule.
+		{"biz", ""},
+		{"domain.biz", "domain.biz"},
+		{"b.domain.biz", "domain.biz"},
+		{"a.b.domain.biz", "domain.biz"},
+		// TLD with some 2-level rules.
+		{"com", ""},
+		{"example.com", "example.com"},
+		{"b.example.com", "example.com"},
+		{"a.b.example.com", "example.com"},
+		{"uk.com", ""},
+		{"example.uk.com", "example.uk.com"},
+		{"b.example.uk.com", "example.uk.com"},
+		{"a.b.example.uk.com", "example.uk.com"},
+		{"test.ac", "test.ac"},
+		// TLD with only 1 (wildcard) rule.
+		{"mm", ""},
+		{"c.mm", ""},
+		{"b.c.mm", "b.c.mm"},
+		{"a.b.c.mm", "b.c.mm"},
+		// More complex TLD.
+		{"jp", ""},
+		{"test.jp", "test.jp"},
+		{"www.test.jp", "test.jp"},
+		{"ac.jp", ""},
+		{"test.ac.jp", "test.ac.jp"},
+		{"www.test.ac.jp", "test.ac.jp"},
+		{"kyoto.jp", ""},
+		{"test.kyoto.jp", "test.kyoto.jp"},
+		{"ide.kyoto.jp", ""},
+		{"b.ide.kyoto.jp", "b.ide.kyoto.jp"},
+		{"a.b.ide.kyoto.jp", "b.ide.kyoto.jp"},
+		{"c.kobe.jp", ""},
+		{"b.c.kobe.jp", "b.c.kobe.jp"},
+		{"a.b.c.kobe.jp", "b.c.kobe.jp"},
+		{"city.kobe.jp", "city.kobe.jp"},
+		{"www.city.kobe.jp", "city.kobe.jp"},
+		// TLD with a wildcard rule and exceptions.
+		{"ck", ""},
+		{"test.ck", ""},
+		{"b.test.ck", "b.test.ck"},
+		{"a.b.test.ck", "b.test.ck"},
+		{"www.ck", "www.ck"},
+		{"www.www.ck", "www.ck"},
+		// US K12.
+		{"us", ""},
+		{"test.us", "test.us"},
+		{"www.test.us", "test.us"},
+		{"ak.us", ""},
+		{"test.ak.us", "test.ak.us"},
+		{"www.test.ak.us", "test.ak.us"},
+		{"k12.ak.us", ""},
+		{"test.k12.ak.us", "test.k12.ak.us"},
+		{"www.test.k12.ak.us", "test.k12.ak.us"},
+		// IDN labels.
+		{"食狮.com.cn", "食狮.com.cn"},
+		{"食狮.公司.cn", "食狮.公司.cn"},
+		{"www.食狮.公司.cn", "食狮.公司.cn"},
+		{"shishi.公司.cn", "shishi.公司.cn"},
+		{"公司.cn", ""},
+		{"食狮.中国", "食狮.中国"},
+		{"www.食狮.中国", "食狮.中国"},
+		{"shishi.中国", "shishi.中国"},
+		{"中国", ""},
+		// Same as above, but punycoded.
+		{"xn--85x722f.com.cn", "xn--85x722f.com.cn"},
+		{"xn--85x722f.xn--55qx5d.cn", "xn--85x722f.xn--55qx5d.cn"},
+		{"www.xn--85x722f.xn--55qx5d.cn", "xn--85x722f.xn--55qx5d.cn"},
+		{"shishi.xn--55qx5d.cn", "shishi.xn--55qx5d.cn"},
+		{"xn--55qx5d.cn", ""},
+		{"xn--85x722f.xn--fiqs8s", "xn--85x722f.xn--fiqs8s"},
+		{"www.xn--85x722f.xn--fiqs8s", "xn--85x722f.xn--fiqs8s"},
+		{"shishi.xn--fiqs8s", "shishi.xn--fiqs8s"},
+		{"xn--fiqs8s", ""},
+	}
+	for _, v := range vectors {
+		registered, err := psl.RegisteredDomain(v.name)
+		if v.registered == "" {
+			test.AssertError(t, err, v.name)
+		} else {
+			test.AssertNotError(t, err, v.name)
+		}
+		test.AssertEquals(t, registered, v.registered)
+	}
+
+	// The compiled-in list handles simple cases
+	registered, err := RegisteredDomain("www.zombo.co.uk")
+	test.AssertNotError(t, err, "Failed to find registered domain")
+	test.AssertEquals(t, registered, "zombo.co.uk")
+	_, err = RegisteredDomain("co.uk")
+	test.AssertError(t, err, "Public suffix has no registered domain")
+
+	// Including its wildcard and exception rules
+	for name, want := range map[string]string{
+		"www.ck":                "www.ck",
+		"foo.www.ck":            "www.ck",
+		"foo.bar.ck":            "foo.bar.ck",
+		"city.kawasaki.jp":      "city.kawasaki.jp",
+		"www.city.kawasaki.jp":  "city.kawasaki.jp",
+		"www.zombo.kawasaki.jp": "www.zombo.kawasaki.jp",
+	} {
+		registered, err = RegisteredDomain(name)
+		test.AssertNotError(t, err, name)
+		test.AssertEquals(t, registered, want)
+	}
+	_, err = RegisteredDomain("bar.ck")
+	test.AssertError(t, err, "Wildcard public suffix has no registered domain")
+
+	// The compiled-in maps come from the same copy of the list
+	for wildcard := range PublicSuffixWildcards {
+		test.Assert(t, PublicSuffixList[wildcard], "Wildcard base missing from PublicSuffixList: "+wildcard)
+	}
+	for exception := range PublicSuffixExceptions {
+		parent := exception[strings.Index(exception, ".")+1:]
+		test.Assert(t, PublicSuffixWildcards[parent], "Exception without a wildcard rule: "+exception)
+	}
+
+	// And the default policy authority applies the wildcard and exception
+	// rules too
+	defaultPA := NewPolicyAuthorityImpl()
+	err = defaultPA.WillingToIssue(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "foo.kawasaki.jp"})
+	if _, ok := err.(NonPublicError); !ok {
+		t.Error("Name under a wildcard rule was not forbidden")
+	}
+	test.Assert(t, !defaultPA.IsPublicName("foo.kawasaki.jp"), "Name under a wildcard rule is public")
+	test.Assert(t, !IsPublicName("foo.kawasaki.jp"), "Name under a wildcard rule is public")
+	err = defaultPA.WillingToIssue(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "www.city.kawasaki.jp"})
+	test.AssertNotError(t, err, "Refused a name under an exception rule")
+	test.Assert(t, defaultPA.IsPublicName("bar.foo.kawasaki.jp"), "Name below a wildcard suffix isn't public")
+
+	// As does the policy authority, with or without a configured list
+	pa := NewPolicyAuthorityImpl()
+	registered, err = pa.RegisteredDomain("www.zombo.com")
+	test.AssertNotError(t, err, "Failed to find registered domain")
+	test.AssertEquals(t, registered, "zombo.com")
+}
@@ -36,14 +36,41 @@ type RegistrationAuthorityImpl struct {
 
 	AuthzBase  string
 	MaxKeySize int
+
+	// CAA is rechecked when issuing a certificate for any name whose
+	// authorization's CAA check is older than this
+	CAARecheckWindow time.Duration
+
+	// Valid authorizations expire after AuthorizationLifetime, or after the
+	// entry in AuthorizationLifetimes for a validated challenge's type if it
+	// has one.  If several challenges were validated, the shortest of their
+	// lifetimes is used.
+	AuthorizationLifetime  time.Duration
+	AuthorizationLifetimes map[string]time.Duration
+
+	// DefaultProfile is the issuance profile asked of the CA for requests
+	// that don't name one. If empty, the CA's default profile is used.
+	DefaultProfile string
+	// ProfileAllowLists limits issuance profiles to the listed registration
+	// IDs. Profiles without an allow-list can be used by any registration.
+	ProfileAllowLists map[string][]int64
+
+	// MustStapleAllowList lists the registration IDs whose certificates may
+	// have the OCSP Must-Staple extension their CSRs ask for.
+	MustStapleAllowList []int64
 }
 
+// DefaultAuthorizationLifetime is how long valid authorizations can be used
+// if no other lifetime is configured.
+const DefaultAuthorizationLifetime = 365 * 24 * time.Hour
+
 // NewRegistrationAuthorityImpl constructs a new RA object.
 func NewRegistrationAuthorityImpl() RegistrationAuthorityImpl {
 	logger := blog.GetAuditLogger()
 	logger.Notice("Registration Authority Starting")
 
 	ra := RegistrationAuthorityImpl{log: logger}
+	ra.AuthorizationLifetime = DefaultAuthorizationLifetime
 	ra.PA = policy.NewPolicyAuthorityImpl()
 	return ra
 }
@@ -99,6 +126,8 @@ type certificateRequestEvent struct {
 	VerifiedFields      []string  `json:",omitempty"`
 	CommonName          string    `json:",omitempty"`
 	Names               []string  `json:",omitempty"`
+	Profile             string    `json:",omitempty"`
+	MustStaple          bool      `json:",omitempty"`
 	NotBefore           time.Time `json:",omitempty"`
 	NotAfter            time.Time `json:",omitempty"`
 	RequestTime         time.Time `json:",omitempty"`
@@ -149,7 +178,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(request core.Authorization
 	}
 
 	// Check CAA records for the requested identifier
-	present, valid, err := ra.VA.CheckCAARecords(identifier)
+	present, valid, err := ra.VA.CheckCAARecords(identifier, regID, nil)
 	if err != nil {
 		return authz, err
 	}
@@ -159,6 +188,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(request core.Authorization
 		err = errors.New("CAA check for identifier failed")
 		return authz, err
 	}
+	caaChecked := time.Now()
 
 	// Create validations, but we have to update them with URIs later
 	challenges, combinations := ra.PA.ChallengesFor(identifier)
@@ -169,6 +199,7 @@ func (ra *RegistrationAuthorityImpl) NewAuthorization(request core.Authorization
 		RegistrationID: regID,
 		Status:         core.StatusPending,
 		Combinations:   combinations,
+		CAAChecked:     &caaChecked,
 	}
 
 	// Get a pending Auth first so we can get our ID back, then update with challenges
@@ -248,12 +279,42 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 		return emptyCert, err
 	}
 
-	logEvent.CommonName = csr.Subject.CommonName
-	logEvent.Names = csr.DNSNames
+	// OCSP Must-Staple is only included for registrations allowed it
+	mustStaple, err := core.RequestsMustStaple(csr)
+	if err != nil {
+		err = core.MalformedRequestError(err.Error())
+		logEvent.Error = err.Error()
+		return emptyCert, err
+	}
+	if mustStaple && !containsID(ra.MustStapleAllowList, regID) {
+		err = core.UnauthorizedError(fmt.Sprintf("Registration %d may not request OCSP Must-Staple", regID))
+		logEvent.Error = err.Error()
+		return emptyCert, err
+	}
+	logEvent.MustStaple = mustStaple
+
+	// IP addresses belong in the IP address SANs. The CA would move one
+	// found among the DNS names there, and the certificate would no longer
+	// match the CSR.
+	for _, name := range csr.DNSNames {
+		if net.ParseIP(name) != nil {
+			err = core.MalformedRequestError(fmt.Sprintf("IP address %s must be requested as an IP address SAN", name))
+			logEvent.Error = err.Error()
+			return emptyCert, err
+		}
+	}
 
-	// Validate that authorization key is authorized for all domains
+	// Validate that authorization key is authorized for all domains and IP
+	// addresses
 	names := make([]string, len(csr.DNSNames))
 	copy(names, csr.DNSNames)
+	for _, ip := range csr.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	logEvent.CommonName = csr.Subject.CommonName
+	logEvent.Names = names
+
 	if len(csr.Subject.CommonName) > 0 {
 		names = append(names, csr.Subject.CommonName)
 	}
@@ -280,8 +341,10 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 		return emptyCert, err
 	}
 
-	// Gather authorized domains from the referenced authorizations
-	authorizedDomains := map[string]bool{}
+	// Gather authorized identifiers from the referenced authorizations
+	authorizedIdentifiers := map[core.AcmeIdentifier]bool{}
+	identifierMethods := map[core.AcmeIdentifier][]string{}
+	caaCheckTimes := map[core.AcmeIdentifier]time.Time{}
 	verificationMethodSet := map[string]bool{}
 	earliestExpiry := time.Date(2100, 01, 01, 0, 0, 0, 0, time.UTC)
 	now := time.Now()
@@ -292,7 +355,7 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 			authz.RegistrationID != registration.ID || // Not for this account
 			authz.Status != core.StatusValid || // Not finalized or not successful
 			authz.Expires.Before(now) || // Expired
-			authz.Identifier.Type != core.IdentifierDNS {
+			(authz.Identifier.Type != core.IdentifierDNS && authz.Identifier.Type != core.IdentifierIP) {
 			// XXX: It may be good to fail here instead of ignoring invalid authorizations.
 			//      However, it seems like this treatment is more in the spirit of Postel's
 			//      law, and it hides information from attackers.
@@ -306,10 +369,15 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 		for _, challenge := range authz.Challenges {
 			if challenge.Status == core.StatusValid {
 				verificationMethodSet[challenge.Type] = true
+				identifierMethods[authz.Identifier] = append(identifierMethods[authz.Identifier], challenge.Type)
 			}
 		}
 
-		authorizedDomains[authz.Identifier.Value] = true
+		if authz.CAAChecked != nil && authz.CAAChecked.After(caaCheckTimes[authz.Identifier]) {
+			caaCheckTimes[authz.Identifier] = *authz.CAAChecked
+		}
+
+		authorizedIdentifiers[authz.Identifier] = true
 	}
 	verificationMethods := []string{}
 	for method := range verificationMethodSet {
@@ -317,9 +385,9 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 	}
 	logEvent.VerificationMethods = verificationMethods
 
-	// Validate all domains
+	// Validate all domains and IP addresses
 	for _, name := range names {
-		if !authorizedDomains[name] {
+		if !authorizedIdentifiers[core.IdentifierForName(name)] {
 			err = core.UnauthorizedError(fmt.Sprintf("Key not authorized for name %s", name))
 			logEvent.Error = err.Error()
 			return emptyCert, err
@@ -329,8 +397,44 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 	// Mark that we verified the CN and SANs
 	logEvent.VerifiedFields = []string{"subject.commonName", "subjectAltName"}
 
+	// Recheck CAA for names whose authorizations' CAA checks are stale, so
+	// that records added since then are respected
+	caaChecked := map[core.AcmeIdentifier]bool{}
+	for _, name := range names {
+		identifier := core.IdentifierForName(name)
+		if caaChecked[identifier] || now.Sub(caaCheckTimes[identifier]) < ra.CAARecheckWindow {
+			continue
+		}
+		caaChecked[identifier] = true
+
+		// An empty (rather than nil) list tells the VA that validation is done
+		methods := identifierMethods[identifier]
+		if methods == nil {
+			methods = []string{}
+		}
+		present, valid, err := ra.VA.CheckCAARecords(identifier, regID, methods)
+		if err != nil {
+			logEvent.Error = err.Error()
+			return emptyCert, err
+		}
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		ra.log.Audit(fmt.Sprintf("Rechecked CAA records for %s, registration ID %d, methods %v [Present: %v, Valid for issuance: %v]", identifier.Value, regID, methods, present, valid))
+		if !valid {
+			err = core.UnauthorizedError(fmt.Sprintf("CAA records for %s do not authorize issuance", name))
+			logEvent.Error = err.Error()
+			return emptyCert, err
+		}
+	}
+
+	profile, err := ra.selectProfile(req.Profile, regID)
+	if err != nil {
+		logEvent.Error = err.Error()
+		return emptyCert, err
+	}
+	logEvent.Profile = profile
+
 	// Create the certificate and log the result
-	if cert, err = ra.CA.IssueCertificate(*csr, regID, earliestExpiry); err != nil {
+	if cert, err = ra.CA.IssueCertificate(*csr, regID, earliestExpiry, profile); err != nil {
 		// While this could be InternalServerError for certain conditions, most
 		// of the failure reasons (such as GoodKey failing) are caused by malformed
 		// requests.
@@ -364,6 +468,30 @@ func (ra *RegistrationAuthorityImpl) NewCertificate(req core.CertificateRequest,
 	return cert, nil
 }
 
+// selectProfile chooses the issuance profile for a certificate request: the
+// one the request asks for, or the RA's default. The registration must be on
+// the profile's allow-list, if it has one.
+func (ra *RegistrationAuthorityImpl) selectProfile(requested string, regID int64) (string, error) {
+	profile := requested
+	if profile == "" {
+		profile = ra.DefaultProfile
+	}
+	allowList, restricted := ra.ProfileAllowLists[profile]
+	if restricted && !containsID(allowList, regID) {
+		return "", core.UnauthorizedError(fmt.Sprintf("Registration %d may not use issuance profile %s", regID, profile))
+	}
+	return profile, nil
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, listed := range ids {
+		if listed == id {
+			return true
+		}
+	}
+	return false
+}
+
 // UpdateRegistration updates an existing Registration with new values.
 func (ra *RegistrationAuthorityImpl) UpdateRegistration(base core.Registration, update core.Registration) (reg core.Registration, err error) {
 	base.MergeUpdate(update)
@@ -422,36 +550,153 @@ func (ra *RegistrationAuthorityImpl) RevokeCertificate(cert x509.Certificate) (e
 	return err
 }
 
-// OnValidationUpdate is called when a given Authorization is updated by the VA.
-func (ra *RegistrationAuthorityImpl) OnValidationUpdate(authz core.Authorization) error {
-	// Consider validation successful if any of the combinations
-	// specified in the authorization has been fulfilled
-	validated := map[int]bool{}
-	for i, ch := range authz.Challenges {
-		if ch.Status == core.StatusValid {
-			validated[i] = true
+// authorizationLifetime returns how long a valid authorization can be used,
+// based on the types of its validated challenges.
+func (ra *RegistrationAuthorityImpl) authorizationLifetime(authz core.Authorization) time.Duration {
+	var lifetime time.Duration
+	for _, ch := range authz.Challenges {
+		if ch.Status != core.StatusValid {
+			continue
+		}
+		challengeLifetime, ok := ra.AuthorizationLifetimes[ch.Type]
+		if !ok {
+			challengeLifetime = ra.AuthorizationLifetime
+		}
+		if lifetime == 0 || challengeLifetime < lifetime {
+			lifetime = challengeLifetime
 		}
 	}
+	if lifetime == 0 {
+		lifetime = ra.AuthorizationLifetime
+	}
+	return lifetime
+}
+
+// challengeFinished returns true if a challenge has reached a final status
+func challengeFinished(status core.AcmeStatus) bool {
+	return status == core.StatusValid || status == core.StatusInvalid || status == core.StatusRevoked
+}
+
+// authorizationStatus determines the status of an authorization from its
+// challenges.  It is valid once every challenge in one of its combinations is
+// valid, pending while some combination can still be satisfied, and invalid
+// once no combination can be.
+func authorizationStatus(authz core.Authorization) core.AcmeStatus {
+	possible := false
 	for _, combo := range authz.Combinations {
-		comboValid := true
+		if len(combo) == 0 {
+			continue
+		}
+		satisfied, failed := true, false
 		for _, i := range combo {
-			if !validated[i] {
-				comboValid = false
+			if i < 0 || i >= len(authz.Challenges) {
+				failed = true
 				break
 			}
+			switch authz.Challenges[i].Status {
+			case core.StatusValid:
+			case core.StatusInvalid, core.StatusRevoked:
+				failed = true
+			default:
+				satisfied = false
+			}
+		}
+		if failed {
+			continue
+		}
+		if satisfied {
+			return core.StatusValid
 		}
-		if comboValid {
-			authz.Status = core.StatusValid
+		possible = true
+	}
+	if possible {
+		return core.StatusPending
+	}
+	return core.StatusInvalid
+}
+
+// Attempts made to store a validation result while results of other
+// validations of the same authorization are being stored
+const maxValidationUpdateAttempts = 3
+
+// OnValidationUpdate is called when a given Authorization is updated by the VA.
+func (ra *RegistrationAuthorityImpl) OnValidationUpdate(update core.Authorization) (err error) {
+	// The SA refuses writes that would discard results stored since they
+	// were read, so if another validation finishes in the meantime, read its
+	// result and try again
+	for attempt := 0; attempt < maxValidationUpdateAttempts; attempt++ {
+		var authz core.Authorization
+		if authz, err = ra.mergeValidationUpdate(update); err != nil {
+			return
+		}
+		if err = ra.storeValidationUpdate(authz); err == nil {
+			return
+		}
+	}
+	return
+}
+
+// mergeValidationUpdate takes the results of any other validations of this
+// authorization that finished meanwhile from the stored copy, since the VA
+// only updates the challenge it validated.
+func (ra *RegistrationAuthorityImpl) mergeValidationUpdate(update core.Authorization) (authz core.Authorization, err error) {
+	stored, err := ra.SA.GetAuthorization(update.ID)
+	if err != nil {
+		return
+	}
+	if stored.Status == core.StatusValid || stored.Status == core.StatusInvalid {
+		err = fmt.Errorf("Authorization %s is already %s", update.ID, stored.Status)
+		return
+	}
+	if len(stored.Challenges) != len(update.Challenges) {
+		err = fmt.Errorf("Authorization %s has %d challenges, but the update has %d",
+			update.ID, len(stored.Challenges), len(update.Challenges))
+		return
+	}
+
+	authz = update
+	authz.Challenges = append([]core.Challenge{}, update.Challenges...)
+	for i, ch := range stored.Challenges {
+		if !challengeFinished(authz.Challenges[i].Status) && challengeFinished(ch.Status) {
+			authz.Challenges[i] = ch
+		}
+	}
+	return
+}
+
+// storeValidationUpdate decides the status of the merged authorization and
+// stores it, finalizing it once it's valid or invalid.
+func (ra *RegistrationAuthorityImpl) storeValidationUpdate(authz core.Authorization) error {
+	// Validation is successful once any of the combinations specified in
+	// the authorization has been fulfilled.  While other combinations can
+	// still be, the authorization stays pending.
+	authz.Status = authorizationStatus(authz)
+	if authz.Status == core.StatusPending {
+		return ra.SA.UpdatePendingAuthorization(authz)
+	}
+
+	// Check CAA again now that the validation methods are known, since CAA
+	// records can restrict them
+	if authz.Status == core.StatusValid {
+		methods := []string{}
+		for _, ch := range authz.Challenges {
+			if ch.Status == core.StatusValid {
+				methods = append(methods, ch.Type)
+			}
+		}
+		present, valid, err := ra.VA.CheckCAARecords(authz.Identifier, authz.RegistrationID, methods)
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		ra.log.Audit(fmt.Sprintf("Checked CAA records for %s, registration ID %d, methods %v [Present: %v, Valid for issuance: %v, Error: %v]", authz.Identifier.Value, authz.RegistrationID, methods, present, valid, err))
+		if err != nil || !valid {
+			authz.Status = core.StatusInvalid
+		} else {
+			caaChecked := time.Now()
+			authz.CAAChecked = &caaChecked
 		}
 	}
 
-	// If no validation succeeded, then the authorization is invalid
-	// NOTE: This only works because we only ever do one validation
-	if authz.Status != core.StatusValid {
-		authz.Status = core.StatusInvalid
-	} else {
-		// TODO: Enable configuration of expiry time
-		exp := time.Now().Add(365 * 24 * time.Hour)
+	if authz.Status == core.StatusValid {
+		exp := time.Now().Add(ra.authorizationLifetime(authz))
 		authz.Expires = &exp
 	}
 
@@ -45,6 +45,7 @@ const (
 	MethodCheckCAARecords             = "CheckCAARecords"             // VA
 	MethodIssueCertificate            = "IssueCertificate"            // CA
 	MethodGenerateOCSP                = "GenerateOCSP"                // CA
+	MethodGenerateCRL                 = "GenerateCRL"                 // CA
 	MethodGetRegistration             = "GetRegistration"             // SA
 	MethodGetRegistrationByKey        = "GetRegistrationByKey"        // RA, SA
 	MethodGetAuthorization            = "GetAuthorization"            // SA
@@ -58,6 +59,8 @@ const (
 	MethodFinalizeAuthorization       = "FinalizeAuthorization"       // SA
 	MethodAddCertificate              = "AddCertificate"              // SA
 	MethodAlreadyDeniedCSR            = "AlreadyDeniedCSR"            // SA
+	MethodAddSCTReceipt               = "AddSCTReceipt"               // SA
+	MethodGetSCTReceipts              = "GetSCTReceipts"              // SA
 )
 
 // Request structs
@@ -93,11 +96,13 @@ type issueCertificateRequest struct {
 	Bytes          []byte
 	RegID          int64
 	EarliestExpiry time.Time
+	Profile        string
 }
 
 type addCertificateRequest struct {
-	Bytes []byte
-	RegID int64
+	Bytes   []byte
+	RegID   int64
+	Profile string
 }
 
 type revokeCertificateRequest struct {
@@ -112,7 +117,9 @@ type markCertificateRevokedRequest struct {
 }
 
 type caaRequest struct {
-	Ident core.AcmeIdentifier
+	Ident          core.AcmeIdentifier
+	RegID          int64
+	ChallengeTypes []string
 }
 
 type validationRequest struct {
@@ -433,7 +440,7 @@ func NewValidationAuthorityServer(rpc RPCServer, impl core.ValidationAuthority)
 			return
 		}
 
-		present, valid, err := impl.CheckCAARecords(caaReq.Ident)
+		present, valid, err := impl.CheckCAARecords(caaReq.Ident, caaReq.RegID, caaReq.ChallengeTypes)
 		if err != nil {
 			return
 		}
@@ -480,9 +487,11 @@ func (vac ValidationAuthorityClient) UpdateValidations(authz core.Authorization,
 }
 
 // CheckCAARecords sends a request to check CAA records
-func (vac ValidationAuthorityClient) CheckCAARecords(ident core.AcmeIdentifier) (present bool, valid bool, err error) {
+func (vac ValidationAuthorityClient) CheckCAARecords(ident core.AcmeIdentifier, regID int64, challengeTypes []string) (present bool, valid bool, err error) {
 	var caaReq caaRequest
 	caaReq.Ident = ident
+	caaReq.RegID = regID
+	caaReq.ChallengeTypes = challengeTypes
 	data, err := json.Marshal(caaReq)
 	if err != nil {
 		return
@@ -525,7 +534,7 @@ func NewCertificateAuthorityServer(rpc RPCServer, impl core.CertificateAuthority
 			return
 		}
 
-		cert, err := impl.IssueCertificate(*csr, icReq.RegID, icReq.EarliestExpiry)
+		cert, err := impl.IssueCertificate(*csr, icReq.RegID, icReq.EarliestExpiry, icReq.Profile)
 		if err != nil {
 			return
 		}
@@ -570,6 +579,19 @@ func NewCertificateAuthorityServer(rpc RPCServer, impl core.CertificateAuthority
 		return
 	})
 
+	rpc.Handle(MethodGenerateCRL, func(req []byte) (response []byte, err error) {
+		var xferObj core.CRLSigningRequest
+		err = json.Unmarshal(req, &xferObj)
+		if err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			errorCondition(MethodGenerateCRL, err, req)
+			return
+		}
+
+		response, err = impl.GenerateCRL(xferObj)
+		return
+	})
+
 	return nil
 }
 
@@ -585,10 +607,11 @@ func NewCertificateAuthorityClient(client RPCClient) (cac CertificateAuthorityCl
 }
 
 // IssueCertificate sends a request to issue a certificate
-func (cac CertificateAuthorityClient) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time) (cert core.Certificate, err error) {
+func (cac CertificateAuthorityClient) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time, profile string) (cert core.Certificate, err error) {
 	var icReq issueCertificateRequest
 	icReq.Bytes = csr.Raw
 	icReq.RegID = regID
+	icReq.Profile = profile
 	data, err := json.Marshal(icReq)
 	if err != nil {
 		return
@@ -640,6 +663,26 @@ func (cac CertificateAuthorityClient) GenerateOCSP(signRequest core.OCSPSigningR
 	return
 }
 
+// GenerateCRL sends a request to sign a CRL
+func (cac CertificateAuthorityClient) GenerateCRL(signRequest core.CRLSigningRequest) (crl []byte, err error) {
+	data, err := json.Marshal(signRequest)
+	if err != nil {
+		// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+		errorCondition(MethodGenerateCRL, err, signRequest)
+		return
+	}
+
+	crl, err = cac.rpc.DispatchSync(MethodGenerateCRL, data)
+	if err != nil {
+		return
+	}
+	if len(crl) < 1 {
+		err = fmt.Errorf("Failure at Signer")
+		return
+	}
+	return
+}
+
 // NewStorageAuthorityServer constructs an RPC server
 func NewStorageAuthorityServer(rpc RPCServer, impl core.StorageAuthority) error {
 	rpc.Handle(MethodUpdateRegistration, func(req []byte) (response []byte, err error) {
@@ -723,7 +766,7 @@ func NewStorageAuthorityServer(rpc RPCServer, impl core.StorageAuthority) error
 			return
 		}
 
-		id, err := impl.AddCertificate(acReq.Bytes, acReq.RegID)
+		id, err := impl.AddCertificate(acReq.Bytes, acReq.RegID, acReq.Profile)
 		if err != nil {
 			return
 		}
@@ -847,6 +890,33 @@ func NewStorageAuthorityServer(rpc RPCServer, impl core.StorageAuthority) error
 		return
 	})
 
+	rpc.Handle(MethodGetSCTReceipts, func(req []byte) (response []byte, err error) {
+		scts, err := impl.GetSCTReceipts(string(req))
+		if err != nil {
+			return
+		}
+
+		response, err = json.Marshal(scts)
+		if err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			errorCondition(MethodGetSCTReceipts, err, req)
+			return
+		}
+		return
+	})
+
+	rpc.Handle(MethodAddSCTReceipt, func(req []byte) (response []byte, err error) {
+		var sct core.SignedCertificateTimestamp
+		if err = json.Unmarshal(req, &sct); err != nil {
+			// AUDIT[ Improper Messages ] 0786b6f2-91ca-4f48-9883-842a19084c64
+			improperMessage(MethodAddSCTReceipt, err, req)
+			return
+		}
+
+		err = impl.AddSCTReceipt(sct)
+		return
+	})
+
 	rpc.Handle(MethodMarkCertificateRevoked, func(req []byte) (response []byte, err error) {
 		var mcrReq markCertificateRevokedRequest
 
@@ -968,7 +1038,7 @@ func (cac StorageAuthorityClient) GetCertificate(id string) (cert core.Certifica
 }
 
 // GetCertificateByShortSerial sends a request to search for a certificate by
-// the predictable portion of its serial number.
+// the first half of its serial number.
 func (cac StorageAuthorityClient) GetCertificateByShortSerial(id string) (cert core.Certificate, err error) {
 	jsonCert, err := cac.rpc.DispatchSync(MethodGetCertificateByShortSerial, []byte(id))
 	if err != nil {
@@ -991,6 +1061,28 @@ func (cac StorageAuthorityClient) GetCertificateStatus(id string) (status core.C
 	return
 }
 
+// GetSCTReceipts sends a request to obtain the SCTs stored for a certificate
+func (cac StorageAuthorityClient) GetSCTReceipts(serial string) (scts []core.SignedCertificateTimestamp, err error) {
+	jsonSCTs, err := cac.rpc.DispatchSync(MethodGetSCTReceipts, []byte(serial))
+	if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(jsonSCTs, &scts)
+	return
+}
+
+// AddSCTReceipt sends a request to store an SCT returned by a CT log
+func (cac StorageAuthorityClient) AddSCTReceipt(sct core.SignedCertificateTimestamp) (err error) {
+	data, err := json.Marshal(sct)
+	if err != nil {
+		return
+	}
+
+	_, err = cac.rpc.DispatchSync(MethodAddSCTReceipt, data)
+	return
+}
+
 // MarkCertificateRevoked sends a request to mark a certificate as revoked
 func (cac StorageAuthorityClient) MarkCertificateRevoked(serial string, ocspResponse []byte, reasonCode int) (err error) {
 	var mcrReq markCertificateRevokedRequest
@@ -1097,10 +1189,11 @@ func (cac StorageAuthorityClient) FinalizeAuthorization(authz core.Authorization
 }
 
 // AddCertificate sends a request to record the issuance of a certificate
-func (cac StorageAuthorityClient) AddCertificate(cert []byte, regID int64) (id string, err error) {
+func (cac StorageAuthorityClient) AddCertificate(cert []byte, regID int64, profile string) (id string, err error) {
 	var acReq addCertificateRequest
 	acReq.Bytes = cert
 	acReq.RegID = regID
+	acReq.Profile = profile
 	data, err := json.Marshal(acReq)
 	if err != nil {
 		return
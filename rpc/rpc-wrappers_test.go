@@ -98,3 +98,15 @@ func TestGenerateOCSP(t *testing.T) {
 	_, err = client.GenerateOCSP(req)
 	test.AssertError(t, err, "Should have failed at signer")
 }
+
+func TestGenerateCRL(t *testing.T) {
+	mock := &MockRPCClient{}
+
+	client, err := NewCertificateAuthorityClient(mock)
+	test.AssertNotError(t, err, "Client construction")
+
+	mock.NextResp = []byte{}
+	_, err = client.GenerateCRL(core.CRLSigningRequest{Issuer: "default", Number: 1})
+	test.AssertError(t, err, "Should have failed at signer")
+	test.AssertEquals(t, "GenerateCRL", mock.LastMethod)
+}
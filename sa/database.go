@@ -95,6 +95,9 @@ func initTables(dbMap *gorp.DbMap) {
 	dbMap.AddTableWithName(core.Certificate{}, "certificates").SetKeys(false, "Serial")
 	dbMap.AddTableWithName(core.CertificateStatus{}, "certificateStatus").SetKeys(false, "Serial").SetVersionCol("LockCol")
 	dbMap.AddTableWithName(core.OCSPResponse{}, "ocspResponses").SetKeys(true, "ID")
-	dbMap.AddTableWithName(core.CRL{}, "crls").SetKeys(false, "Serial")
+	dbMap.AddTableWithName(core.CRL{}, "crls").SetKeys(true, "ID").SetUniqueTogether("issuer", "number")
 	dbMap.AddTableWithName(core.DeniedCSR{}, "deniedCSRs").SetKeys(true, "ID")
+	dbMap.AddTableWithName(core.SignedCertificateTimestamp{}, "sctReceipts").SetKeys(true, "ID").SetUniqueTogether("certificateSerial", "logID")
+	ctSubmissionTable := dbMap.AddTableWithName(core.CTSubmission{}, "ctSubmissions").SetKeys(true, "ID").SetUniqueTogether("serial", "logID")
+	ctSubmissionTable.SetVersionCol("LockCol")
 }
@@ -8,6 +8,7 @@ package sa
 import (
 	"crypto/sha256"
 	"crypto/x509"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -22,11 +23,19 @@ import (
 	blog "github.com/letsencrypt/boulder/log"
 )
 
+// DefaultPendingAuthorizationLifetime is how long pending authorizations
+// remain usable if no other lifetime is configured.
+const DefaultPendingAuthorizationLifetime = 7 * 24 * time.Hour
+
 // SQLStorageAuthority defines a Storage Authority
 type SQLStorageAuthority struct {
 	dbMap  *gorp.DbMap
 	bucket map[string]interface{} // XXX included only for backward compat
 	log    *blog.AuditLogger
+
+	// PendingAuthorizationLifetime is how long a new pending authorization
+	// can be used before it expires.
+	PendingAuthorizationLifetime time.Duration
 }
 
 func digest256(data []byte) []byte {
@@ -62,6 +71,8 @@ func NewSQLStorageAuthority(driver string, name string) (ssa *SQLStorageAuthorit
 		dbMap:  dbMap,
 		log:    logger,
 		bucket: make(map[string]interface{}),
+
+		PendingAuthorizationLifetime: DefaultPendingAuthorizationLifetime,
 	}
 
 	return
@@ -82,6 +93,29 @@ func statusIsPending(status core.AcmeStatus) bool {
 	return status == core.StatusPending || status == core.StatusProcessing || status == core.StatusUnknown
 }
 
+// pendingExpired returns true if the pending authorization has passed its
+// expiry time.  Pending authorizations created before expiry times were set
+// on them don't expire until expired-authz-purger gives them an expiry.
+func pendingExpired(authz core.Authorization) bool {
+	return authz.Expires != nil && authz.Expires.Before(time.Now())
+}
+
+// discardsResults returns true if storing the update would replace the
+// result of a challenge that has already finished in the stored pending
+// authorization, as happens when the update was based on a copy read before
+// another validation of the authorization finished.
+func discardsResults(stored, update core.Authorization) bool {
+	for i, ch := range stored.Challenges {
+		if statusIsPending(ch.Status) {
+			continue
+		}
+		if i >= len(update.Challenges) || update.Challenges[i].Status != ch.Status {
+			return true
+		}
+	}
+	return false
+}
+
 func existingPending(tx *gorp.Transaction, id string) bool {
 	var count int64
 	_ = tx.SelectOne(&count, "SELECT count(*) FROM pending_authz WHERE id = :id", map[string]interface{}{"id": id})
@@ -160,31 +194,42 @@ func (ssa *SQLStorageAuthority) GetAuthorization(id string) (authz core.Authoriz
 		return
 	}
 	authD := *authObj.(*pendingauthzModel)
+	if pendingExpired(authD.Authorization) {
+		err = fmt.Errorf("Pending authorization %s has expired", id)
+		tx.Rollback()
+		return
+	}
 	authz = authD.Authorization
 
 	err = tx.Commit()
 	return
 }
 
-// GetCertificateByShortSerial takes an id consisting of the first, sequential half of a
-// serial number and returns the first certificate whose full serial number is
-// lexically greater than that id. This allows clients to query on the known
-// sequential half of our serial numbers to enumerate all certificates.
+// GetCertificateByShortSerial takes an id consisting of the first half of a
+// serial number, either sequential or random, and returns the certificate
+// whose full serial number starts with that id. If there is none, a
+// core.NotFoundError is returned, which the CA relies on to check new serials
+// for uniqueness.
 func (ssa *SQLStorageAuthority) GetCertificateByShortSerial(shortSerial string) (cert core.Certificate, err error) {
-	if len(shortSerial) != 16 {
+	if !core.ValidShortSerial(shortSerial) {
 		err = errors.New("Invalid certificate short serial " + shortSerial)
 		return
 	}
 
+	// Each "_" matches exactly one character, so that a 16-character sequential
+	// id can't match the prefix of an 18-character random one.
 	err = ssa.dbMap.SelectOne(&cert, "SELECT * FROM certificates WHERE serial LIKE :shortSerial",
-		map[string]interface{}{"shortSerial": shortSerial + "%"})
+		map[string]interface{}{"shortSerial": shortSerial + strings.Repeat("_", 16)})
+	if err == sql.ErrNoRows {
+		err = core.NotFoundError(fmt.Sprintf("No certificate found for short serial %s", shortSerial))
+	}
 	return
 }
 
 // GetCertificate takes a serial number and returns the corresponding
 // certificate, or error if it does not exist.
 func (ssa *SQLStorageAuthority) GetCertificate(serial string) (core.Certificate, error) {
-	if len(serial) != 32 {
+	if !core.ValidSerial(serial) {
 		err := fmt.Errorf("Invalid certificate serial %s", serial)
 		return core.Certificate{}, err
 	}
@@ -206,11 +251,11 @@ func (ssa *SQLStorageAuthority) GetCertificate(serial string) (core.Certificate,
 	return *certPtr, err
 }
 
-// GetCertificateStatus takes a hexadecimal string representing the full 128-bit serial
+// GetCertificateStatus takes a hexadecimal string representing the full serial
 // number of a certificate and returns data about that certificate's current
 // validity.
 func (ssa *SQLStorageAuthority) GetCertificateStatus(serial string) (status core.CertificateStatus, err error) {
-	if len(serial) != 32 {
+	if !core.ValidSerial(serial) {
 		err = errors.New("Invalid certificate serial " + serial)
 		return
 	}
@@ -362,6 +407,9 @@ func (ssa *SQLStorageAuthority) NewPendingAuthorization(authz core.Authorization
 		authz.ID = core.NewToken()
 	}
 
+	exp := time.Now().Add(ssa.PendingAuthorizationLifetime)
+	authz.Expires = &exp
+
 	// Insert a stub row in pending
 	pendingAuthz := pendingauthzModel{Authorization: authz}
 	err = tx.Insert(&pendingAuthz)
@@ -406,6 +454,18 @@ func (ssa *SQLStorageAuthority) UpdatePendingAuthorization(authz core.Authorizat
 		return
 	}
 	auth := authObj.(*pendingauthzModel)
+	if pendingExpired(auth.Authorization) {
+		err = fmt.Errorf("Pending authorization %s has expired", authz.ID)
+		tx.Rollback()
+		return
+	}
+	if discardsResults(auth.Authorization, authz) {
+		err = fmt.Errorf("Update would discard challenge results stored for authorization %s", authz.ID)
+		tx.Rollback()
+		return
+	}
+	// The expiry time is fixed when the authorization is created
+	authz.Expires = auth.Expires
 	auth.Authorization = authz
 	_, err = tx.Update(auth)
 	if err != nil {
@@ -455,6 +515,11 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization(authz core.Authorization)
 		return
 	}
 	oldAuth := authObj.(*pendingauthzModel)
+	if discardsResults(oldAuth.Authorization, authz) {
+		err = fmt.Errorf("Update would discard challenge results stored for authorization %s", authz.ID)
+		tx.Rollback()
+		return
+	}
 
 	err = tx.Insert(auth)
 	if err != nil {
@@ -472,8 +537,9 @@ func (ssa *SQLStorageAuthority) FinalizeAuthorization(authz core.Authorization)
 	return
 }
 
-// AddCertificate stores an issued certificate.
-func (ssa *SQLStorageAuthority) AddCertificate(certDER []byte, regID int64) (digest string, err error) {
+// AddCertificate stores an issued certificate, along with the name of the
+// issuance profile it was issued with.
+func (ssa *SQLStorageAuthority) AddCertificate(certDER []byte, regID int64, profile string) (digest string, err error) {
 	var parsedCertificate *x509.Certificate
 	parsedCertificate, err = x509.ParseCertificate(certDER)
 	if err != nil {
@@ -489,6 +555,7 @@ func (ssa *SQLStorageAuthority) AddCertificate(certDER []byte, regID int64) (dig
 		DER:            certDER,
 		Issued:         time.Now(),
 		Expires:        parsedCertificate.NotAfter,
+		Profile:        profile,
 	}
 	certStatus := &core.CertificateStatus{
 		SubscriberApproved: false,
@@ -522,6 +589,26 @@ func (ssa *SQLStorageAuthority) AddCertificate(certDER []byte, regID int64) (dig
 	return
 }
 
+// AddSCTReceipt stores an SCT a CT log returned for a certificate.
+func (ssa *SQLStorageAuthority) AddSCTReceipt(sct core.SignedCertificateTimestamp) error {
+	if !core.ValidSerial(sct.CertificateSerial) {
+		return errors.New("Invalid certificate serial " + sct.CertificateSerial)
+	}
+	return ssa.dbMap.Insert(&sct)
+}
+
+// GetSCTReceipts returns the SCTs stored for a certificate, if any.
+func (ssa *SQLStorageAuthority) GetSCTReceipts(serial string) (scts []core.SignedCertificateTimestamp, err error) {
+	if !core.ValidSerial(serial) {
+		err = errors.New("Invalid certificate serial " + serial)
+		return
+	}
+
+	_, err = ssa.dbMap.Select(&scts, "SELECT * FROM sctReceipts WHERE certificateSerial = :serial ORDER BY id",
+		map[string]interface{}{"serial": serial})
+	return
+}
+
 // AlreadyDeniedCSR queries to find if the name list has already been denied.
 func (ssa *SQLStorageAuthority) AlreadyDeniedCSR(names []string) (already bool, err error) {
 	sort.Strings(names)
@@ -12,6 +12,7 @@ import (
 	"crypto/x509/pkix"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/url"
 	"time"
 
@@ -139,7 +140,7 @@ func TestAddCertificate(t *testing.T) {
 	certDER, err := ioutil.ReadFile("www.eff.org.der")
 	test.AssertNotError(t, err, "Couldn't read example cert DER")
 
-	digest, err := sa.AddCertificate(certDER, 1)
+	digest, err := sa.AddCertificate(certDER, 1, "")
 	test.AssertNotError(t, err, "Couldn't add www.eff.org.der")
 	test.AssertEquals(t, digest, "qWoItDZmR4P9eFbeYgXXP3SR4ApnkQj8x4LsB_ORKBo")
 
@@ -162,7 +163,7 @@ func TestAddCertificate(t *testing.T) {
 	certDER2, err := ioutil.ReadFile("test-cert.der")
 	test.AssertNotError(t, err, "Couldn't read example cert DER")
 
-	digest2, err := sa.AddCertificate(certDER2, 1)
+	digest2, err := sa.AddCertificate(certDER2, 1, "")
 	test.AssertNotError(t, err, "Couldn't add test-cert.der")
 	test.AssertEquals(t, digest2, "CMVYqWzyqUW7pfBF2CxL0Uk6I0Upsk7p4EWSnd_vYx4")
 
@@ -192,6 +193,50 @@ func TestGetCertificateByShortSerial(t *testing.T) {
 
 	_, err = sa.GetCertificateByShortSerial("01020304050607080102030405060708")
 	test.AssertError(t, err, "Should've failed on too-long serial")
+
+	_, err = sa.GetCertificateByShortSerial("0102030405060708")
+	_, ok := err.(core.NotFoundError)
+	test.Assert(t, ok, "Should've returned NotFoundError for unknown serial")
+
+	_, err = sa.GetCertificateByShortSerial("ff0102030405060708")
+	_, ok = err.(core.NotFoundError)
+	test.Assert(t, ok, "Should've returned NotFoundError for unknown random serial")
+}
+
+// TestRandomSerial checks that certificates with 136-bit random serials can be
+// stored and looked up by short and full serial.
+func TestRandomSerial(t *testing.T) {
+	sa := initSA(t)
+
+	key, _ := rsa.GenerateKey(rand.Reader, 512)
+	serial, _ := new(big.Int).SetString("ff00000000000000022238054509817da5", 16)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "random.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	test.AssertNotError(t, err, "Couldn't create test certificate")
+
+	_, err = sa.AddCertificate(certDER, 1, "shortlived")
+	test.AssertNotError(t, err, "Couldn't add certificate with random serial")
+
+	retrievedCert, err := sa.GetCertificateByShortSerial("ff0000000000000002")
+	test.AssertNotError(t, err, "Couldn't get certificate by random short serial")
+	test.AssertByteEquals(t, certDER, retrievedCert.DER)
+	test.AssertEquals(t, retrievedCert.Profile, "shortlived")
+
+	// A sequential short serial must not match the start of a random serial.
+	_, err = sa.GetCertificateByShortSerial("ff00000000000000")
+	test.AssertError(t, err, "Sequential short serial matched a random serial")
+
+	retrievedCert, err = sa.GetCertificate("ff00000000000000022238054509817da5")
+	test.AssertNotError(t, err, "Couldn't get certificate by random full serial")
+	test.AssertByteEquals(t, certDER, retrievedCert.DER)
+
+	_, err = sa.GetCertificateStatus("ff00000000000000022238054509817da5")
+	test.AssertNotError(t, err, "Couldn't get status for certificate with random serial")
 }
 
 func TestDeniedCSR(t *testing.T) {
@@ -208,3 +253,100 @@ func TestDeniedCSR(t *testing.T) {
 	test.AssertNotError(t, err, "AlreadyDeniedCSR failed")
 	test.Assert(t, !exists, "Found non-existent CSR")
 }
+
+func TestPendingAuthorizationExpiry(t *testing.T) {
+	sa := initSA(t)
+
+	before := time.Now()
+	PA, err := sa.NewPendingAuthorization(core.Authorization{})
+	test.AssertNotError(t, err, "Couldn't create new pending authorization")
+	test.Assert(t, PA.Expires != nil, "Pending authorization has no expiry time")
+	test.Assert(t, !PA.Expires.Before(before.Add(DefaultPendingAuthorizationLifetime)), "Pending authorization expires too soon")
+
+	// The expiry time can't be extended by an update
+	exp := time.Now().AddDate(1, 0, 0)
+	PA.Expires = &exp
+	PA.Status = core.StatusPending
+	err = sa.UpdatePendingAuthorization(PA)
+	test.AssertNotError(t, err, "Couldn't update pending authorization")
+	dbPa, err := sa.GetAuthorization(PA.ID)
+	test.AssertNotError(t, err, "Couldn't get pending authorization")
+	test.Assert(t, dbPa.Expires.Before(exp), "Update changed the expiry time")
+
+	// Expired pending authorizations can't be fetched or updated
+	sa.PendingAuthorizationLifetime = -time.Hour
+	expired, err := sa.NewPendingAuthorization(core.Authorization{})
+	test.AssertNotError(t, err, "Couldn't create new pending authorization")
+	_, err = sa.GetAuthorization(expired.ID)
+	test.AssertError(t, err, "Got an expired pending authorization")
+	expired.Status = core.StatusPending
+	err = sa.UpdatePendingAuthorization(expired)
+	test.AssertError(t, err, "Updated an expired pending authorization")
+}
+
+func TestPendingAuthorizationStaleUpdate(t *testing.T) {
+	sa := initSA(t)
+
+	PA, err := sa.NewPendingAuthorization(core.Authorization{
+		Status: core.StatusPending,
+		Challenges: []core.Challenge{
+			core.Challenge{Type: "simpleHttp", Status: core.StatusPending},
+			core.Challenge{Type: "dvsni", Status: core.StatusPending},
+		},
+	})
+	test.AssertNotError(t, err, "Couldn't create new pending authorization")
+	stale := PA
+	stale.Challenges = append([]core.Challenge{}, PA.Challenges...)
+
+	PA.Challenges[0].Status = core.StatusValid
+	err = sa.UpdatePendingAuthorization(PA)
+	test.AssertNotError(t, err, "Couldn't update pending authorization")
+
+	// Updates made from a copy read before the result was stored are refused
+	stale.Challenges[1].Status = core.StatusInvalid
+	err = sa.UpdatePendingAuthorization(stale)
+	test.AssertError(t, err, "Update discarded a stored challenge result")
+	stale.Status = core.StatusInvalid
+	err = sa.FinalizeAuthorization(stale)
+	test.AssertError(t, err, "Finalization discarded a stored challenge result")
+
+	PA.Challenges[1].Status = core.StatusInvalid
+	PA.Status = core.StatusInvalid
+	err = sa.FinalizeAuthorization(PA)
+	test.AssertNotError(t, err, "Couldn't finalize authorization")
+}
+
+func TestSCTReceipts(t *testing.T) {
+	sa := initSA(t)
+
+	serial := "ff00000000000000022238054509817da5"
+	sct := core.SignedCertificateTimestamp{
+		LogID:             "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=",
+		Timestamp:         1234,
+		Signature:         []byte{4, 3, 0, 1, 9},
+		CertificateSerial: serial,
+	}
+	err := sa.AddSCTReceipt(sct)
+	test.AssertNotError(t, err, "Couldn't add SCT")
+	err = sa.AddSCTReceipt(sct)
+	test.AssertError(t, err, "Added the same log's SCT twice")
+
+	sct.LogID = "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+	err = sa.AddSCTReceipt(sct)
+	test.AssertNotError(t, err, "Couldn't add SCT from a second log")
+
+	scts, err := sa.GetSCTReceipts(serial)
+	test.AssertNotError(t, err, "Couldn't get SCTs")
+	test.AssertEquals(t, len(scts), 2)
+	test.AssertEquals(t, scts[1].LogID, sct.LogID)
+	test.AssertEquals(t, scts[1].Timestamp, uint64(1234))
+	test.AssertByteEquals(t, scts[1].Signature, sct.Signature)
+
+	scts, err = sa.GetSCTReceipts("00000000000000000000000000021bd4")
+	test.AssertNotError(t, err, "Couldn't get SCTs")
+	test.AssertEquals(t, len(scts), 0)
+
+	sct.CertificateSerial = "123"
+	err = sa.AddSCTReceipt(sct)
+	test.AssertError(t, err, "Added SCT for invalid serial")
+}
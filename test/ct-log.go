@@ -0,0 +1,190 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+)
+
+var poisonOID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}
+
+// MockCTLog is an in-process RFC 6962 log that returns a valid SCT for
+// anything submitted to its add-chain and add-pre-chain endpoints. It doesn't
+// keep a Merkle tree.
+type MockCTLog struct {
+	*httptest.Server
+	// Key is the log's base64-encoded DER public key
+	Key string
+
+	mu          sync.Mutex
+	fail        bool
+	submissions int
+	signer      *ecdsa.PrivateKey
+	id          [32]byte
+}
+
+// NewMockCTLog starts a MockCTLog with a fresh P-256 key. Close it when done.
+func NewMockCTLog() *MockCTLog {
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	keyDER, err := x509.MarshalPKIXPublicKey(&signer.PublicKey)
+	if err != nil {
+		panic(err)
+	}
+	log := &MockCTLog{
+		Key:    base64.StdEncoding.EncodeToString(keyDER),
+		signer: signer,
+		id:     sha256.Sum256(keyDER),
+	}
+	log.Server = httptest.NewServer(log)
+	return log
+}
+
+// SetFail makes the log reject all submissions with a server error, or
+// accept them again.
+func (log *MockCTLog) SetFail(fail bool) {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	log.fail = fail
+}
+
+// Submissions returns how many submissions the log has received.
+func (log *MockCTLog) Submissions() int {
+	log.mu.Lock()
+	defer log.mu.Unlock()
+	return log.submissions
+}
+
+// ServeHTTP handles add-chain and add-pre-chain requests.
+func (log *MockCTLog) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	log.mu.Lock()
+	log.submissions++
+	fail := log.fail
+	log.mu.Unlock()
+	if fail {
+		http.Error(response, "log unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	var req struct {
+		Chain [][]byte `json:"chain"`
+	}
+	if err := json.NewDecoder(request.Body).Decode(&req); err != nil || len(req.Chain) < 2 {
+		http.Error(response, "bad chain", http.StatusBadRequest)
+		return
+	}
+	leaf, err := x509.ParseCertificate(req.Chain[0])
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var entryType uint16
+	var entry []byte
+	switch request.URL.Path {
+	case "/ct/v1/add-chain":
+		entry = uint24(leaf.Raw)
+	case "/ct/v1/add-pre-chain":
+		issuer, err := x509.ParseCertificate(req.Chain[1])
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		tbs, err := stripPoison(leaf.RawTBSCertificate)
+		if err != nil {
+			http.Error(response, err.Error(), http.StatusBadRequest)
+			return
+		}
+		entryType = 1
+		issuerKeyHash := sha256.Sum256(issuer.RawSubjectPublicKeyInfo)
+		entry = append(issuerKeyHash[:], uint24(tbs)...)
+	default:
+		http.NotFound(response, request)
+		return
+	}
+
+	timestamp := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	var signed bytes.Buffer
+	signed.Write([]byte{0, 0}) // v1, certificate_timestamp
+	binary.Write(&signed, binary.BigEndian, timestamp)
+	binary.Write(&signed, binary.BigEndian, entryType)
+	signed.Write(entry)
+	signed.Write([]byte{0, 0}) // no extensions
+	digest := sha256.Sum256(signed.Bytes())
+	sig, err := log.signer.Sign(rand.Reader, digest[:], nil)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// DigitallySigned: SHA-256, ECDSA
+	digitallySigned := append([]byte{4, 3, byte(len(sig) >> 8), byte(len(sig))}, sig...)
+
+	json.NewEncoder(response).Encode(map[string]interface{}{
+		"sct_version": 0,
+		"id":          log.id[:],
+		"timestamp":   timestamp,
+		"extensions":  "",
+		"signature":   digitallySigned,
+	})
+}
+
+func uint24(data []byte) []byte {
+	return append([]byte{byte(len(data) >> 16), byte(len(data) >> 8), byte(len(data))}, data...)
+}
+
+// stripPoison rebuilds a TBSCertificate without the poison extension.
+func stripPoison(tbsDER []byte) ([]byte, error) {
+	var tbs asn1.RawValue
+	if _, err := asn1.Unmarshal(tbsDER, &tbs); err != nil {
+		return nil, err
+	}
+	var fields []byte
+	for rest := tbs.Bytes; len(rest) > 0; {
+		var field asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+			return nil, err
+		}
+		if field.Class == asn1.ClassContextSpecific && field.Tag == 3 {
+			var exts []pkix.Extension
+			if _, err = asn1.Unmarshal(field.Bytes, &exts); err != nil {
+				return nil, err
+			}
+			var kept []pkix.Extension
+			for _, ext := range exts {
+				if !ext.Id.Equal(poisonOID) {
+					kept = append(kept, ext)
+				}
+			}
+			seq, err := asn1.Marshal(kept)
+			if err != nil {
+				return nil, err
+			}
+			field.FullBytes, err = asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 3, IsCompound: true, Bytes: seq})
+			if err != nil {
+				return nil, err
+			}
+		}
+		fields = append(fields, field.FullBytes...)
+	}
+	return asn1.Marshal(asn1.RawValue{Tag: asn1.TagSequence, IsCompound: true, Bytes: fields})
+}
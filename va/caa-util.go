@@ -8,13 +8,13 @@ package va
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
 
 	"github.com/letsencrypt/boulder/core"
-	"github.com/letsencrypt/boulder/policy"
 )
 
 // CAA Holds decoded CAA record.
@@ -42,7 +42,8 @@ func newCAA(encodedRDATA []byte) *CAA {
 		// badly formatted record, discard
 		return nil
 	}
-	tag := string(encodedRDATA[2 : 2+tagLen])
+	// Property tags are case-insensitive
+	tag := strings.ToLower(string(encodedRDATA[2 : 2+tagLen]))
 
 	// Only decode tags we understand, value/valuebuf can be empty
 	// (that would be weird though...)
@@ -104,44 +105,90 @@ func newCAASet(CAAs []*CAA) *CAASet {
 	return &CAASet{issue: issueSet, issuewild: issuewildSet, iodef: iodefSet, unknown: unknownSet}
 }
 
-// Looks up CNAME records for domain and returns either the target or ""
-func lookupCNAME(dnsResolver *core.DNSResolver, domain string) (string, error) {
+// Limits on the relevant RRset search, to bound the work done for names with
+// long or looping CNAME/DNAME chains.
+const (
+	maxCAAAliasDepth = 8  // CNAME/DNAME records followed in one chain
+	maxCAALookups    = 64 // DNS queries made in total
+)
+
+// parseIssueValue splits the value of an issue or issuewild property into
+// the issuer domain name and its parameters, as described in RFC 6844
+// Section 5.2:
+//
+//   issuevalue  = space [domain] space [";" *(space parameter) space]
+//   parameter   = tag "=" value
+//
+// An empty issuer domain name (e.g. ";") authorizes no issuer.  ok is false
+// if the value is malformed, in which case it authorizes no issuer either.
+func parseIssueValue(value string) (issuer string, params map[string]string, ok bool) {
+	parts := strings.SplitN(value, ";", 2)
+	issuer = strings.ToLower(strings.TrimRight(strings.TrimSpace(parts[0]), "."))
+	for _, ch := range []byte(issuer) {
+		if !(('a' <= ch && ch <= 'z') || ('0' <= ch && ch <= '9') || ch == '.' || ch == '-') {
+			return "", nil, false
+		}
+	}
+
+	params = map[string]string{}
+	if len(parts) == 1 {
+		return issuer, params, true
+	}
+	for _, param := range strings.Split(parts[1], ";") {
+		param = strings.TrimSpace(param)
+		if param == "" {
+			continue
+		}
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return "", nil, false
+		}
+		key := strings.TrimSpace(kv[0])
+		val := strings.TrimSpace(kv[1])
+		if key == "" || strings.ContainsAny(key, " \t") || strings.ContainsAny(val, " \t") {
+			return "", nil, false
+		}
+		params[strings.ToLower(key)] = val
+	}
+	return issuer, params, true
+}
+
+// Looks up the alias target of domain, following a CNAME record at domain or
+// a DNAME record at one of its ancestors. Returns "" if there is none.
+func lookupAlias(dnsResolver *core.DNSResolver, domain string) (string, core.DNSSECStatus, error) {
+	domain = dns.Fqdn(domain)
 	m := new(dns.Msg)
 	m.SetQuestion(domain, dns.TypeCNAME)
 
-	r, _, err := dnsResolver.LookupDNSSEC(m)
+	r, status, _, err := dnsResolver.LookupDNSSECStatus(m)
 	if err != nil {
-		return "", err
+		return "", status, err
 	}
 
 	for _, answer := range r.Answer {
-		if cname, ok := answer.(*dns.CNAME); ok {
-			return cname.Target, nil
+		switch rr := answer.(type) {
+		case *dns.CNAME:
+			if strings.EqualFold(rr.Hdr.Name, domain) {
+				return rr.Target, status, nil
+			}
+		case *dns.DNAME:
+			owner := rr.Hdr.Name
+			if len(domain) > len(owner) && strings.HasSuffix(strings.ToLower(domain), "."+strings.ToLower(owner)) {
+				return domain[:len(domain)-len(owner)] + rr.Target, status, nil
+			}
 		}
 	}
 
-	return "", nil
+	return "", status, nil
 }
 
-func getCaa(dnsResolver *core.DNSResolver, domain string, alias bool) ([]*CAA, error) {
-	if alias {
-		// Check if there is a CNAME record for domain
-		canonName, err := lookupCNAME(dnsResolver, dns.Fqdn(domain))
-		if err != nil {
-			return nil, err
-		}
-		if canonName == "" || canonName == domain {
-			return []*CAA{}, nil
-		}
-		domain = canonName
-	}
-
+func getCaa(dnsResolver *core.DNSResolver, domain string) ([]*CAA, core.DNSSECStatus, error) {
 	m := new(dns.Msg)
 	m.SetQuestion(dns.Fqdn(domain), dns.TypeCAA)
 
-	r, _, err := dnsResolver.LookupDNSSEC(m)
+	r, status, _, err := dnsResolver.LookupDNSSECStatus(m)
 	if err != nil {
-		return nil, err
+		return nil, status, err
 	}
 
 	var CAAs []*CAA
@@ -151,56 +198,139 @@ func getCaa(dnsResolver *core.DNSResolver, domain string, alias bool) ([]*CAA, e
 			if len(recordFields) < 7 {
 				// Malformed record
 				err = errors.New("DNS response contains badly formatted CAA record")
-				return nil, err
+				return nil, status, err
 			}
 
 			caaLen, err := strconv.Atoi(recordFields[5])
 			if err != nil {
-				return nil, err
+				return nil, status, err
 			}
 
 			// Decode hex encoded RDATA field
 			caaData, err := hex.DecodeString(strings.Join(recordFields[6:], ""))
 			if err != nil {
-				return nil, err
+				return nil, status, err
 			}
 
 			if caaLen != len(caaData) {
 				// Malformed record
 				err = errors.New("RDATA length field doesn't match RDATA length")
-				return nil, err
+				return nil, status, err
+			}
+			if caa := newCAA([]byte(caaData)); caa != nil {
+				CAAs = append(CAAs, caa)
 			}
-			CAAs = append(CAAs, newCAA([]byte(caaData)))
 		}
 	}
 
-	return CAAs, nil
+	return CAAs, status, nil
+}
+
+// caaSearch tracks the state of a single relevant RRset search
+type caaSearch struct {
+	resolver         *core.DNSResolver
+	registeredDomain func(string) (string, error)
+	lookups          int
+	aliases          map[string]bool
+	// status is the weakest DNSSEC validation result of the lookups made
+	status core.DNSSECStatus
 }
 
-func getCaaSet(domain string, dnsResolver *core.DNSResolver) (*CAASet, error) {
-	domain = strings.TrimRight(domain, ".")
-	splitDomain := strings.Split(domain, ".")
-	// RFC 6844 CAA set query sequence, 'x.y.z.com' => ['x.y.z.com', 'y.z.com', 'z.com']
-	for i := range splitDomain {
-		queryDomain := strings.Join(splitDomain[i:], ".")
-		// Don't query a public suffix
-		if _, present := policy.PublicSuffixList[queryDomain]; present {
-			break
+// recordStatus notes the DNSSEC result of a lookup; the search is only secure
+// if every lookup was.
+func (s *caaSearch) recordStatus(status core.DNSSECStatus) {
+	if s.status == "" || s.status == core.DNSSECSecure {
+		s.status = status
+	}
+}
+
+func (s *caaSearch) countLookup() error {
+	s.lookups++
+	if s.lookups > maxCAALookups {
+		return fmt.Errorf("CAA search exceeded %d DNS lookups", maxCAALookups)
+	}
+	return nil
+}
+
+// relevantRRset implements the RFC 6844 Section 4 search for the relevant CAA
+// RRset R(X) of domain X:
+//
+//   * If CAA(X) is not empty, R(X) = CAA(X), otherwise
+//   * If A(X) is not null, and R(A(X)) is not empty, then R(X) = R(A(X)),
+//     otherwise
+//   * If X is not the registered domain, then R(X) = R(P(X)), otherwise
+//   * R(X) is empty.
+//
+// where A(X) is the target of a CNAME or DNAME alias record for X, and P(X)
+// is the parent domain of X.  The search does not climb above the registered
+// domain, since public suffixes do not publish CAA policy for their children.
+func (s *caaSearch) relevantRRset(domain string, aliasDepth int) ([]*CAA, error) {
+	domain = strings.ToLower(strings.TrimRight(domain, "."))
+	for {
+		if err := s.countLookup(); err != nil {
+			return nil, err
+		}
+		CAAs, status, err := getCaa(s.resolver, domain)
+		s.recordStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		if len(CAAs) > 0 {
+			return CAAs, nil
 		}
 
-		// Query CAA records for domain and its alias if it has a CNAME
-		for _, alias := range []bool{false, true} {
-			CAAs, err := getCaa(dnsResolver, queryDomain, alias)
+		if err := s.countLookup(); err != nil {
+			return nil, err
+		}
+		target, status, err := lookupAlias(s.resolver, domain)
+		s.recordStatus(status)
+		if err != nil {
+			return nil, err
+		}
+		target = strings.ToLower(strings.TrimRight(target, "."))
+		if target != "" && target != domain {
+			if aliasDepth >= maxCAAAliasDepth {
+				return nil, fmt.Errorf("CAA search for %s followed more than %d aliases", domain, maxCAAAliasDepth)
+			}
+			if s.aliases[target] {
+				return nil, fmt.Errorf("CAA search found an alias loop at %s", target)
+			}
+			s.aliases[target] = true
+			CAAs, err = s.relevantRRset(target, aliasDepth+1)
 			if err != nil {
 				return nil, err
 			}
-
 			if len(CAAs) > 0 {
-				return newCAASet(CAAs), nil
+				return CAAs, nil
 			}
 		}
+
+		// Stop at the registered domain (or if the name is itself a public
+		// suffix); otherwise move on to the parent
+		registered, err := s.registeredDomain(domain)
+		if err != nil || domain == registered {
+			return nil, nil
+		}
+		domain = domain[strings.Index(domain, ".")+1:]
 	}
+}
 
-	// no CAA records found
-	return nil, nil
+// getCaaSet finds the relevant CAA RRset for domain, also returning the
+// weakest DNSSEC validation result of the lookups made to find it.  The search
+// stops at the registered domain that registeredDomain finds.
+func getCaaSet(domain string, dnsResolver *core.DNSResolver, registeredDomain func(string) (string, error)) (*CAASet, core.DNSSECStatus, error) {
+	search := caaSearch{
+		resolver:         dnsResolver,
+		registeredDomain: registeredDomain,
+		aliases:          map[string]bool{},
+	}
+	CAAs, err := search.relevantRRset(domain, 0)
+	if err != nil {
+		return nil, search.status, err
+	}
+	if len(CAAs) == 0 {
+		// no CAA records found
+		return nil, search.status, nil
+	}
+	return newCAASet(CAAs), search.status, nil
 }
@@ -0,0 +1,270 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package va
+
+import (
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/policy"
+	"github.com/letsencrypt/boulder/test"
+)
+
+// caaRR builds a CAA record in RFC 3597 form, since the DNS library doesn't
+// have a CAA type.
+func caaRR(name string, flag uint8, tag, value string) dns.RR {
+	rdata := append([]byte{flag, uint8(len(tag))}, []byte(tag+value)...)
+	return &dns.RFC3597{
+		Hdr:   dns.RR_Header{Name: dns.Fqdn(name), Rrtype: dns.TypeCAA, Class: dns.ClassINET, Ttl: 60},
+		Rdata: hex.EncodeToString(rdata),
+	}
+}
+
+func cnameRR(name, target string) dns.RR {
+	return &dns.CNAME{
+		Hdr:    dns.RR_Header{Name: dns.Fqdn(name), Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+		Target: dns.Fqdn(target),
+	}
+}
+
+func dnameRR(name, target string) dns.RR {
+	return &dns.DNAME{
+		Hdr:    dns.RR_Header{Name: dns.Fqdn(name), Rrtype: dns.TypeDNAME, Class: dns.ClassINET, Ttl: 60},
+		Target: dns.Fqdn(target),
+	}
+}
+
+var caaZone = []dns.RR{
+	caaRR("example.com", 0, "issue", "letsencrypt.org"),
+	caaRR("example.com", 0, "iodef", "mailto:security@example.com"),
+	caaRR("other.example.com", 0, "issue", "ca.example.net; account=12345"),
+	caaRR("wild.example.com", 0, "issue", ";"),
+	caaRR("wild.example.com", 0, "issuewild", "LetsEncrypt.org."),
+	caaRR("wild-only.example.com", 0, "issuewild", ";"),
+	caaRR("critical.example.com", 128, "tbs", "unknown"),
+	caaRR("iodef-only.example.net", 0, "iodef", "mailto:security@example.net"),
+	caaRR("target.example.net", 0, "issue", "ca.example.net"),
+	cnameRR("alias.example.org", "target.example.net"),
+	cnameRR("nothing.example.org", "nothing.example.net"),
+	cnameRR("loop-a.example.org", "loop-b.example.org"),
+	cnameRR("loop-b.example.org", "loop-a.example.org"),
+	cnameRR("chain0.example.org", "chain1.example.org"),
+	cnameRR("chain1.example.org", "chain2.example.org"),
+	cnameRR("chain2.example.org", "chain3.example.org"),
+	cnameRR("chain3.example.org", "chain4.example.org"),
+	cnameRR("chain4.example.org", "chain5.example.org"),
+	cnameRR("chain5.example.org", "chain6.example.org"),
+	cnameRR("chain6.example.org", "chain7.example.org"),
+	cnameRR("chain7.example.org", "chain8.example.org"),
+	cnameRR("chain8.example.org", "chain9.example.org"),
+	cnameRR("chain9.example.org", "target.example.net"),
+	dnameRR("moved.example.org", "example.net"),
+	caaRR("com", 0, "issue", "ca.example.net"),
+	caaRR("account.example.com", 0, "issue", "letsencrypt.org; accounturi=https://acme.invalid/reg/42"),
+	caaRR("methods.example.com", 0, "issue", "letsencrypt.org; validationmethods=dns,dvsni"),
+	caaRR("both.example.com", 0, "issue", "letsencrypt.org; accounturi=https://acme.invalid/reg/42; validationmethods=dns"),
+	caaRR("both.example.com", 0, "issue", "ca.example.net"),
+}
+
+// caaHandler answers queries from caaZone, returning DNAME records for names
+// below their owners.
+func caaHandler(w dns.ResponseWriter, r *dns.Msg) {
+	m := new(dns.Msg)
+	m.SetReply(r)
+	if len(r.Question) != 1 {
+		m.Rcode = dns.RcodeFormatError
+		w.WriteMsg(m)
+		return
+	}
+	q := r.Question[0]
+	for _, rr := range caaZone {
+		hdr := rr.Header()
+		if strings.EqualFold(hdr.Name, q.Name) && hdr.Rrtype == q.Qtype {
+			m.Answer = append(m.Answer, rr)
+		} else if hdr.Rrtype == dns.TypeDNAME && strings.HasSuffix(strings.ToLower(q.Name), "."+hdr.Name) {
+			m.Answer = append(m.Answer, rr)
+		}
+	}
+	w.WriteMsg(m)
+}
+
+func caaTestResolver(t *testing.T) (*core.DNSResolver, func()) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen for DNS")
+	server := &dns.Server{PacketConn: pc, Handler: dns.HandlerFunc(caaHandler), ReadTimeout: 100 * time.Millisecond}
+	go server.ActivateAndServe()
+	return core.NewDNSResolver(time.Second, []string{pc.LocalAddr().String()}), func() { server.Shutdown() }
+}
+
+func TestParseIssueValue(t *testing.T) {
+	tests := []struct {
+		value  string
+		issuer string
+		params map[string]string
+		ok     bool
+	}{
+		{"letsencrypt.org", "letsencrypt.org", map[string]string{}, true},
+		{" LetsEncrypt.org. ", "letsencrypt.org", map[string]string{}, true},
+		{";", "", map[string]string{}, true},
+		{"", "", map[string]string{}, true},
+		{"ca.example.net; account=230123", "ca.example.net", map[string]string{"account": "230123"}, true},
+		{"ca.example.net;a=1;; B = 2 ", "ca.example.net", map[string]string{"a": "1", "b": "2"}, true},
+		{"ca.example.net; policy=ev", "ca.example.net", map[string]string{"policy": "ev"}, true},
+		{"ca.example.net; account", "", nil, false},
+		{"ca.example.net; =1", "", nil, false},
+		{"ca.example.net; a=b c", "", nil, false},
+		{"ca example.net", "", nil, false},
+		{"ca_example.net", "", nil, false},
+	}
+	for _, tc := range tests {
+		issuer, params, ok := parseIssueValue(tc.value)
+		test.AssertEquals(t, ok, tc.ok)
+		test.AssertEquals(t, issuer, tc.issuer)
+		test.AssertEquals(t, len(params), len(tc.params))
+		for k, v := range tc.params {
+			test.AssertEquals(t, params[k], v)
+		}
+	}
+}
+
+func TestRelevantRRset(t *testing.T) {
+	resolver, stop := caaTestResolver(t)
+	defer stop()
+
+	tests := []struct {
+		domain string
+		issuer string // issuer of the first issue record, "" if none found
+	}{
+		// Records at the name itself
+		{"example.com", "letsencrypt.org"},
+		// Climbing to a parent
+		{"www.example.com", "letsencrypt.org"},
+		{"a.b.c.example.com", "letsencrypt.org"},
+		// The closest records win
+		{"www.other.example.com", "ca.example.net"},
+		// Following a CNAME
+		{"alias.example.org", "ca.example.net"},
+		// Following a long chain of CNAMEs
+		{"chain2.example.org", "ca.example.net"},
+		// Following a DNAME
+		{"target.moved.example.org", "ca.example.net"},
+		// An alias whose target has no records, and no records above
+		{"nothing.example.org", ""},
+		// No records at all; the search doesn't climb above the registered
+		// domain, so the records at "com" aren't found
+		{"www.example.org", ""},
+	}
+	for _, tc := range tests {
+		caaSet, _, err := getCaaSet(tc.domain, resolver, policy.RegisteredDomain)
+		test.AssertNotError(t, err, tc.domain)
+		if tc.issuer == "" {
+			test.Assert(t, caaSet == nil, "Found unexpected CAA records for "+tc.domain)
+			continue
+		}
+		test.Assert(t, caaSet != nil && len(caaSet.issue) > 0, "Didn't find CAA records for "+tc.domain)
+		issuer, _, _ := parseIssueValue(caaSet.issue[0].value)
+		test.AssertEquals(t, issuer, tc.issuer)
+	}
+
+	// Alias loops and overly long chains are refused
+	_, _, err := getCaaSet("loop-a.example.org", resolver, policy.RegisteredDomain)
+	test.AssertError(t, err, "Followed a CNAME loop")
+	_, _, err = getCaaSet("chain0.example.org", resolver, policy.RegisteredDomain)
+	test.AssertError(t, err, "Followed an overly long CNAME chain")
+
+	// The search stops at the registered domain of the configured list, which
+	// here makes example.com a public suffix
+	rules, err := policy.ParsePublicSuffixRules(strings.NewReader("com\nexample.com\n"))
+	test.AssertNotError(t, err, "Failed to parse public suffix list")
+	caaSet, _, err := getCaaSet("www.example.com", resolver, rules.RegisteredDomain)
+	test.AssertNotError(t, err, "Failed to search for CAA records")
+	test.Assert(t, caaSet == nil, "Climbed above the configured registered domain")
+}
+
+func TestCAACheckingIssuerDomains(t *testing.T) {
+	resolver, stop := caaTestResolver(t)
+	defer stop()
+	va := NewValidationAuthorityImpl(true)
+	va.DNSResolver = resolver
+	va.IssuerDomains = []string{"ca.example.net", "LetsEncrypt.org"}
+
+	tests := []struct {
+		domain  string
+		present bool
+		valid   bool
+	}{
+		{"example.com", true, true},
+		{"www.other.example.com", true, true},
+		{"alias.example.org", true, true},
+		{"www.example.org", false, true},
+		// issuewild applies to wildcards, issue to everything else
+		{"wild.example.com", true, false},
+		{"*.wild.example.com", true, true},
+		// Wildcards fall back to issue if there is no issuewild
+		{"*.example.com", true, true},
+		// issuewild alone only restricts wildcards
+		{"wild-only.example.com", true, true},
+		{"*.wild-only.example.com", true, false},
+		{"critical.example.com", true, false},
+		// iodef alone doesn't restrict issuance
+		{"iodef-only.example.net", true, true},
+	}
+	for _, tc := range tests {
+		present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: tc.domain}, 1, nil)
+		test.AssertNotError(t, err, tc.domain)
+		test.AssertEquals(t, present, tc.present)
+		test.AssertEquals(t, valid, tc.valid)
+	}
+
+	// Only the configured issuer domains are accepted
+	va.IssuerDomains = []string{"letsencrypt.org"}
+	present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "alias.example.org"}, 1, nil)
+	test.AssertNotError(t, err, "CAA check failed")
+	test.Assert(t, present && !valid, "Unlisted issuer domain was accepted")
+}
+
+func TestCAACheckingParameters(t *testing.T) {
+	resolver, stop := caaTestResolver(t)
+	defer stop()
+	va := NewValidationAuthorityImpl(true)
+	va.DNSResolver = resolver
+	va.IssuerDomains = []string{"letsencrypt.org"}
+	va.RegBase = "https://acme.invalid/reg/"
+
+	tests := []struct {
+		domain         string
+		regID          int64
+		challengeTypes []string
+		valid          bool
+	}{
+		{"account.example.com", 42, nil, true},
+		{"account.example.com", 43, nil, false},
+		{"account.example.com", 42, []string{core.ChallengeTypeSimpleHTTP}, true},
+		// Validation methods are only enforced once they are known
+		{"methods.example.com", 1, nil, true},
+		{"methods.example.com", 1, []string{core.ChallengeTypeDNS}, true},
+		{"methods.example.com", 1, []string{core.ChallengeTypeDVSNI, core.ChallengeTypeDNS}, true},
+		{"methods.example.com", 1, []string{core.ChallengeTypeSimpleHTTP}, false},
+		{"methods.example.com", 1, []string{core.ChallengeTypeDNS, core.ChallengeTypeSimpleHTTP}, false},
+		{"methods.example.com", 1, []string{}, false},
+		// Records for other issuers don't widen what ours permit
+		{"both.example.com", 42, []string{core.ChallengeTypeDNS}, true},
+		{"both.example.com", 42, []string{core.ChallengeTypeDVSNI}, false},
+		{"both.example.com", 1, []string{core.ChallengeTypeDNS}, false},
+	}
+	for _, tc := range tests {
+		present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: tc.domain}, tc.regID, tc.challengeTypes)
+		test.AssertNotError(t, err, tc.domain)
+		test.Assert(t, present, "CAA records not found for "+tc.domain)
+		test.AssertEquals(t, valid, tc.valid)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package va
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+)
+
+// Timeout for each connection attempt made during validation
+const validationDialTimeout = 5 * time.Second
+
+// ParseAddressRanges parses a list of CIDR address blocks.
+func ParseAddressRanges(cidrs []string) ([]*net.IPNet, error) {
+	var ranges []*net.IPNet
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, ipNet)
+	}
+	return ranges, nil
+}
+
+// reservedRange returns the range containing ip, if any
+func reservedRange(ranges []*net.IPNet, ip net.IP) *net.IPNet {
+	for _, ipNet := range ranges {
+		if ipNet.Contains(ip) {
+			return ipNet
+		}
+	}
+	return nil
+}
+
+// resolveHost finds the addresses validation may connect to for a host name
+// or address literal, looking names up through the VA's own resolver so that
+// validation sees the same DNS as the CAA and DNS checks.  IPv6 addresses
+// come first, and addresses in reserved ranges are dropped.
+func (va ValidationAuthorityImpl) resolveHost(host string) ([]net.IP, error) {
+	return resolvePublic(va.DNSResolver, va.ReservedRanges, va.log, host)
+}
+
+// resolvePublic looks up a host name or address literal through the
+// resolver, keeping only the addresses outside the reserved ranges.
+func resolvePublic(resolver *core.DNSResolver, reserved []*net.IPNet, log *blog.AuditLogger, host string) ([]net.IP, error) {
+	var addrs []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		addrs = []net.IP{ip}
+	} else {
+		if resolver == nil {
+			return nil, fmt.Errorf("No DNS resolver to look up %s", host)
+		}
+		var err error
+		addrs, _, err = resolver.LookupHost(host)
+		if err != nil {
+			return nil, err
+		}
+		if len(addrs) == 0 {
+			return nil, fmt.Errorf("No addresses found for %s", host)
+		}
+	}
+
+	var usable []net.IP
+	for _, ip := range addrs {
+		if ipNet := reservedRange(reserved, ip); ipNet != nil {
+			// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+			log.Audit(fmt.Sprintf("Refusing to connect to %s for %s: address is in reserved range %s", ip, host, ipNet))
+			continue
+		}
+		usable = append(usable, ip)
+	}
+	if len(usable) == 0 {
+		return nil, fmt.Errorf("No usable addresses for %s: all are in reserved ranges", host)
+	}
+	return usable, nil
+}
+
+// dialAddrs connects to the first of the addresses that accepts a
+// connection on the port, falling back through them in order.
+func (va ValidationAuthorityImpl) dialAddrs(addrs []net.IP, port string) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: validationDialTimeout}
+	var err error
+	for _, ip := range addrs {
+		var conn net.Conn
+		conn, err = dialer.Dial("tcp", net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+		va.log.Debug(fmt.Sprintf("Could not connect to %s: %s", ip, err))
+	}
+	if err == nil {
+		err = fmt.Errorf("No addresses to connect to")
+	}
+	return nil, err
+}
+
+// dial resolves the host of a host:port address and connects to one of its
+// usable addresses.  It is used as the Dial function of validation's HTTP
+// transport, so redirects are checked the same way.
+func (va ValidationAuthorityImpl) dial(network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := va.resolveHost(host)
+	if err != nil {
+		return nil, err
+	}
+	return va.dialAddrs(addrs, port)
+}
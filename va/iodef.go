@@ -0,0 +1,268 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package va
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/http"
+	netmail "net/mail"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/mail"
+	"github.com/letsencrypt/boulder/policy"
+)
+
+// Timeout for each connection attempt made to send a report
+const iodefDialTimeout = 10 * time.Second
+
+// iodefMailer is the part of mail.Mailer used to send reports
+type iodefMailer interface {
+	SendMail(to []string, msg string) error
+}
+
+// IodefReporter sends incident reports to the URLs given in the iodef
+// properties of CAA records that refused issuance (RFC 6844 Section 5.4).
+// mailto: URLs are sent a plain-text report by mail, and https: URLs are sent
+// an IODEF (RFC 5070) document by POST.  Other schemes are ignored.
+type IodefReporter struct {
+	log        *blog.AuditLogger
+	mailer     iodefMailer
+	httpClient *http.Client
+
+	// Issuer is the issuer domain name this CA identifies itself by in reports
+	Issuer string
+	// Interval is the minimum time between reports for one registered domain
+	Interval time.Duration
+	// RegisteredDomain finds the registered domain reports are limited by
+	RegisteredDomain func(name string) (string, error)
+	// DNSResolver looks up the hosts of https: URLs, and reports are never
+	// sent to addresses in ReservedRanges.  iodef URLs come from CAA records,
+	// which anyone can publish, so these should match the VA's.
+	DNSResolver    *core.DNSResolver
+	ReservedRanges []*net.IPNet
+
+	mu         sync.Mutex
+	lastReport map[string]time.Time
+}
+
+// NewIodefReporter constructs an IodefReporter that sends mail through the
+// given Mailer.  If mailer is nil, mailto: reports are not sent.
+func NewIodefReporter(mailer *mail.Mailer, issuer string, interval time.Duration) *IodefReporter {
+	r := &IodefReporter{
+		log:              blog.GetAuditLogger(),
+		Issuer:           issuer,
+		Interval:         interval,
+		RegisteredDomain: policy.RegisteredDomain,
+		ReservedRanges:   policy.ReservedNetworks,
+		lastReport:       map[string]time.Time{},
+	}
+	r.httpClient = &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{Dial: r.dial},
+	}
+	if mailer != nil {
+		r.mailer = mailer
+	}
+	return r
+}
+
+// allow records a report for the domain, returning false if one was already
+// sent within the interval.  Reports are limited per registered domain so that
+// requests for many subdomains don't multiply them.
+func (r *IodefReporter) allow(domain string, now time.Time) bool {
+	key, err := r.RegisteredDomain(domain)
+	if err != nil {
+		key = domain
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if last, present := r.lastReport[key]; present && now.Sub(last) < r.Interval {
+		return false
+	}
+	// Forget domains whose interval has passed, so the map only holds those
+	// that are still limited
+	for limited, last := range r.lastReport {
+		if now.Sub(last) >= r.Interval {
+			delete(r.lastReport, limited)
+		}
+	}
+	r.lastReport[key] = now
+	return true
+}
+
+// dial connects to a host:port address like net.Dial, but looks the host up
+// through DNSResolver and never connects to an address in ReservedRanges, so
+// that reports can't reach the CA's own network.
+func (r *IodefReporter) dial(network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := resolvePublic(r.DNSResolver, r.ReservedRanges, r.log, host)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: iodefDialTimeout}
+	for _, ip := range ips {
+		conn, dialErr := dialer.Dial(network, net.JoinHostPort(ip.String(), port))
+		if dialErr == nil {
+			return conn, nil
+		}
+		err = dialErr
+	}
+	return nil, err
+}
+
+// Report sends a report that issuance for the identifier was refused to each
+// of the iodef records' URLs.
+func (r *IodefReporter) Report(identifier core.AcmeIdentifier, iodefs []*CAA) {
+	if len(iodefs) == 0 {
+		return
+	}
+	now := time.Now()
+	if !r.allow(identifier.Value, now) {
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		r.log.Audit(fmt.Sprintf("CAA iodef report for %s suppressed by rate limit", identifier.Value))
+		return
+	}
+
+	for _, iodef := range iodefs {
+		target, err := url.Parse(strings.TrimSpace(iodef.value))
+		if err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			r.log.AuditErr(fmt.Errorf("CAA iodef report for %s: bad URL %q: %s", identifier.Value, iodef.value, err))
+			continue
+		}
+
+		switch strings.ToLower(target.Scheme) {
+		case "mailto":
+			err = r.sendMail(target, identifier, now)
+		case "https":
+			err = r.post(target, identifier, now)
+		default:
+			err = fmt.Errorf("unsupported URL scheme %q", target.Scheme)
+		}
+
+		if err != nil {
+			// AUDIT[ Error Conditions ] 9cc4d537-8534-4970-8665-4b382abe82f3
+			r.log.AuditErr(fmt.Errorf("CAA iodef report for %s to %s failed: %s", identifier.Value, iodef.value, err))
+			continue
+		}
+		// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+		r.log.Audit(fmt.Sprintf("Sent CAA iodef report for %s to %s", identifier.Value, iodef.value))
+	}
+}
+
+func (r *IodefReporter) description(identifier core.AcmeIdentifier) string {
+	return fmt.Sprintf("A request to %s for a certificate for %s was refused because the "+
+		"domain's CAA records do not authorize it to issue.", r.Issuer, identifier.Value)
+}
+
+func (r *IodefReporter) sendMail(target *url.URL, identifier core.AcmeIdentifier, now time.Time) error {
+	if r.mailer == nil {
+		return fmt.Errorf("no mailer configured")
+	}
+	// mailto:a@example.com,b@example.com?subject=... (RFC 6068).  Addresses
+	// are percent-encoded, but a "+" in one is literal.
+	var to []string
+	for _, address := range strings.Split(target.Opaque, ",") {
+		address, err := url.QueryUnescape(strings.Replace(address, "+", "%2B", -1))
+		if err != nil {
+			return err
+		}
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+		// Only bare addresses, which also keeps line breaks out of the headers
+		parsed, err := netmail.ParseAddress(address)
+		if err != nil || parsed.Name != "" || parsed.Address != address {
+			return fmt.Errorf("bad recipient %q", address)
+		}
+		to = append(to, address)
+	}
+	if len(to) == 0 {
+		return fmt.Errorf("no recipients")
+	}
+
+	msg := fmt.Sprintf("To: %s\r\n"+
+		"Subject: CAA policy violation report for %s\r\n"+
+		"Date: %s\r\n"+
+		"\r\n"+
+		"%s\r\n\r\nTime of request: %s\r\n",
+		strings.Join(to, ", "), identifier.Value, now.Format(time.RFC1123Z),
+		r.description(identifier), now.UTC().Format(time.RFC3339))
+	return r.mailer.SendMail(to, msg)
+}
+
+// A minimal IODEF document, with the elements RFC 5070 requires
+type iodefDocument struct {
+	XMLName  xml.Name      `xml:"urn:ietf:params:xml:ns:iodef-1.0 IODEF-Document"`
+	Version  string        `xml:"version,attr"`
+	Incident iodefIncident `xml:"Incident"`
+}
+
+type iodefIncident struct {
+	Purpose     string `xml:"purpose,attr"`
+	IncidentID  iodefIncidentID
+	ReportTime  string
+	Description string
+	Assessment  struct {
+		Impact struct {
+			Type        string `xml:"type,attr"`
+			Completion  string `xml:"completion,attr"`
+			Description string `xml:",chardata"`
+		}
+	}
+	Contact struct {
+		Type        string `xml:"type,attr"`
+		Role        string `xml:"role,attr"`
+		ContactName string
+	}
+}
+
+type iodefIncidentID struct {
+	Name string `xml:"name,attr"`
+	ID   string `xml:",chardata"`
+}
+
+func (r *IodefReporter) post(target *url.URL, identifier core.AcmeIdentifier, now time.Time) error {
+	var doc iodefDocument
+	doc.Version = "1.00"
+	doc.Incident.Purpose = "reporting"
+	doc.Incident.IncidentID = iodefIncidentID{Name: r.Issuer, ID: core.NewToken()}
+	doc.Incident.ReportTime = now.UTC().Format(time.RFC3339)
+	doc.Incident.Description = r.description(identifier)
+	doc.Incident.Assessment.Impact.Type = "policy"
+	doc.Incident.Assessment.Impact.Completion = "failed"
+	doc.Incident.Assessment.Impact.Description = "Certificate issuance refused for " + identifier.Value
+	doc.Incident.Contact.Type = "organization"
+	doc.Incident.Contact.Role = "creator"
+	doc.Incident.Contact.ContactName = r.Issuer
+
+	body, err := xml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	resp, err := r.httpClient.Post(target.String(), "application/iodef+xml", bytes.NewReader(append([]byte(xml.Header), body...)))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("server returned %s", resp.Status)
+	}
+	return nil
+}
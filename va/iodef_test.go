@@ -0,0 +1,153 @@
+// Copyright 2015 ISRG.  All rights reserved
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package va
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/letsencrypt/boulder/core"
+	"github.com/letsencrypt/boulder/test"
+)
+
+type mockMailer struct {
+	to   [][]string
+	msgs []string
+}
+
+func (m *mockMailer) SendMail(to []string, msg string) error {
+	m.to = append(m.to, to)
+	m.msgs = append(m.msgs, msg)
+	return nil
+}
+
+func TestIodefReport(t *testing.T) {
+	var posted []string
+	var contentType string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		posted = append(posted, string(body))
+		contentType = r.Header.Get("Content-Type")
+	}))
+	defer server.Close()
+
+	mailer := &mockMailer{}
+	reporter := NewIodefReporter(nil, "letsencrypt.org", time.Hour)
+	reporter.mailer = mailer
+	reporter.httpClient = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+
+	iodefs := []*CAA{
+		&CAA{tag: "iodef", value: "mailto:security@example.com,noc%40example.com,caa+report@example.com"},
+		&CAA{tag: "iodef", value: server.URL + "/report"},
+		&CAA{tag: "iodef", value: "ftp://example.com/report"},
+	}
+	ident := core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "www.example.com"}
+	reporter.Report(ident, iodefs)
+
+	test.AssertEquals(t, len(mailer.msgs), 1)
+	test.AssertEquals(t, strings.Join(mailer.to[0], " "), "security@example.com noc@example.com caa+report@example.com")
+	test.Assert(t, strings.Contains(mailer.msgs[0], "Subject: CAA policy violation report for www.example.com\r\n"), "Bad report subject")
+	test.Assert(t, strings.Contains(mailer.msgs[0], "letsencrypt.org"), "Report doesn't name the issuer")
+
+	test.AssertEquals(t, len(posted), 1)
+	test.AssertEquals(t, contentType, "application/iodef+xml")
+	var doc iodefDocument
+	err := xml.Unmarshal([]byte(posted[0]), &doc)
+	test.AssertNotError(t, err, "Failed to parse IODEF document")
+	test.AssertEquals(t, doc.Incident.IncidentID.Name, "letsencrypt.org")
+	test.AssertEquals(t, doc.Incident.Contact.ContactName, "letsencrypt.org")
+	test.Assert(t, strings.Contains(doc.Incident.Description, "www.example.com"), "Report doesn't name the domain")
+
+	// Further reports for the same registered domain are rate-limited
+	reporter.Report(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "mail.example.com"}, iodefs)
+	test.AssertEquals(t, len(mailer.msgs), 1)
+	test.AssertEquals(t, len(posted), 1)
+
+	// But other domains are reported
+	reporter.Report(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "example.net"}, iodefs[:1])
+	test.AssertEquals(t, len(mailer.msgs), 2)
+
+	// Until the interval has passed, when domains that are no longer limited
+	// are forgotten
+	reporter.lastReport["example.com"] = time.Now().Add(-2 * time.Hour)
+	reporter.lastReport["example.net"] = time.Now().Add(-2 * time.Hour)
+	reporter.Report(ident, iodefs[:1])
+	test.AssertEquals(t, len(mailer.msgs), 3)
+	test.AssertEquals(t, len(reporter.lastReport), 1)
+
+	// Recipients that would add headers to the report are refused
+	reporter.Report(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "example.org"},
+		[]*CAA{&CAA{tag: "iodef", value: "mailto:security@example.org%0D%0ABcc:victim@example.com"}})
+	test.AssertEquals(t, len(mailer.msgs), 3)
+
+	// Without a mailer, mailto: reports are skipped
+	reporter = NewIodefReporter(nil, "letsencrypt.org", time.Hour)
+	target, _ := url.Parse(iodefs[0].value)
+	err = reporter.sendMail(target, ident, time.Now())
+	test.AssertError(t, err, "Sent a report without a mailer")
+}
+
+func TestIodefReportReservedAddress(t *testing.T) {
+	var posted int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted++
+	}))
+	defer server.Close()
+
+	// The test server listens on a loopback address, which reports are never
+	// sent to
+	reporter := NewIodefReporter(nil, "letsencrypt.org", time.Hour)
+	target, _ := url.Parse(server.URL + "/report")
+	ident := core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "www.example.com"}
+	err := reporter.post(target, ident, time.Now())
+	test.AssertError(t, err, "Posted a report to a reserved address")
+	test.Assert(t, strings.Contains(err.Error(), "reserved ranges"), "Wrong error: "+err.Error())
+	test.AssertEquals(t, posted, 0)
+}
+
+func TestIodefReportResolver(t *testing.T) {
+	var posted int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posted++
+	}))
+	defer server.Close()
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(server.URL, "https://"))
+	resolver, shutdown := serveHostZone(t)
+	defer shutdown()
+
+	reporter := NewIodefReporter(nil, "letsencrypt.org", time.Hour)
+	reporter.httpClient.Transport = &http.Transport{
+		Dial:            reporter.dial,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	target, _ := url.Parse("https://localhost.example.com:" + port + "/report")
+	ident := core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "www.example.com"}
+
+	// Names are looked up through the VA's resolver, and their reserved
+	// addresses refused
+	reporter.DNSResolver = resolver
+	err := reporter.post(target, ident, time.Now())
+	test.AssertError(t, err, "Posted a report to a reserved address")
+	test.Assert(t, strings.Contains(err.Error(), "reserved ranges"), "Wrong error: "+err.Error())
+	test.AssertEquals(t, posted, 0)
+
+	// The reserved ranges follow the VA's too
+	reporter.ReservedRanges, err = ParseAddressRanges([]string{"10.0.0.0/8"})
+	test.AssertNotError(t, err, "Failed to parse address ranges")
+	err = reporter.post(target, ident, time.Now())
+	test.AssertNotError(t, err, "Failed to post report")
+	test.AssertEquals(t, posted, 1)
+}
@@ -16,17 +16,46 @@ import (
 	"strings"
 	"time"
 
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
 	"github.com/letsencrypt/boulder/core"
 	blog "github.com/letsencrypt/boulder/log"
+	"github.com/letsencrypt/boulder/policy"
 )
 
 // ValidationAuthorityImpl represents a VA
 type ValidationAuthorityImpl struct {
-	RA           core.RegistrationAuthority
-	log          *blog.AuditLogger
-	DNSResolver  *core.DNSResolver
-	IssuerDomain string
-	TestMode     bool
+	RA          core.RegistrationAuthority
+	log         *blog.AuditLogger
+	DNSResolver *core.DNSResolver
+	TestMode    bool
+
+	// IssuerDomains lists the issuer domain names that identify this CA in the
+	// issue and issuewild properties of CAA records.
+	IssuerDomains []string
+
+	// RegBase is the URL prefix of registration resources, which is compared
+	// against the accounturi parameter of CAA records.
+	RegBase string
+
+	// IodefReporter, if set, is sent the iodef records of CAA record sets that
+	// refuse issuance.
+	IodefReporter *IodefReporter
+
+	// CheckReverseZoneCAA controls CAA processing for IP address identifiers.
+	// When set, CAA records are looked up in the address's reverse zone
+	// (in-addr.arpa or ip6.arpa); otherwise CAA checking is skipped for IPs.
+	CheckReverseZoneCAA bool
+
+	// ReservedRanges are address blocks that validation refuses to connect
+	// to, policy.ReservedNetworks by default.  Outside of test mode,
+	// challenge hosts are looked up through DNSResolver and connected to by
+	// address.
+	ReservedRanges []*net.IPNet
+
+	// RegisteredDomain finds the registered domain of a name, above which
+	// the CAA search doesn't climb.  It should follow the PA's public
+	// suffix list.
+	RegisteredDomain func(name string) (string, error)
 }
 
 // NewValidationAuthorityImpl constructs a new VA, and may place it
@@ -34,7 +63,12 @@ type ValidationAuthorityImpl struct {
 func NewValidationAuthorityImpl(tm bool) ValidationAuthorityImpl {
 	logger := blog.GetAuditLogger()
 	logger.Notice("Validation Authority Starting")
-	return ValidationAuthorityImpl{log: logger, TestMode: tm}
+	return ValidationAuthorityImpl{
+		log:              logger,
+		TestMode:         tm,
+		ReservedRanges:   policy.ReservedNetworks,
+		RegisteredDomain: policy.RegisteredDomain,
+	}
 }
 
 // Used for audit logging
@@ -49,6 +83,15 @@ type verificationRequestEvent struct {
 
 // Validation methods
 
+// urlHost formats an identifier for use as the host portion of a URL,
+// bracketing IPv6 address literals.
+func urlHost(identifier core.AcmeIdentifier) string {
+	if identifier.Type == core.IdentifierIP && strings.Contains(identifier.Value, ":") {
+		return "[" + identifier.Value + "]"
+	}
+	return identifier.Value
+}
+
 func (va ValidationAuthorityImpl) validateSimpleHTTP(identifier core.AcmeIdentifier, input core.Challenge) (core.Challenge, error) {
 	challenge := input
 
@@ -58,12 +101,12 @@ func (va ValidationAuthorityImpl) validateSimpleHTTP(identifier core.AcmeIdentif
 		return challenge, err
 	}
 
-	if identifier.Type != core.IdentifierDNS {
+	if identifier.Type != core.IdentifierDNS && identifier.Type != core.IdentifierIP {
 		challenge.Status = core.StatusInvalid
-		err := fmt.Errorf("Identifier type for SimpleHTTP was not DNS")
+		err := fmt.Errorf("Identifier type for SimpleHTTP was not DNS or IP")
 		return challenge, err
 	}
-	hostName := identifier.Value
+	hostName := urlHost(identifier)
 	var scheme string
 	if input.TLS == nil || (input.TLS != nil && *input.TLS) {
 		scheme = "https"
@@ -94,6 +137,11 @@ func (va ValidationAuthorityImpl) validateSimpleHTTP(identifier core.AcmeIdentif
 		// connection immediately.
 		DisableKeepAlives: true,
 	}
+	if !va.TestMode {
+		// Connect to the addresses our resolver finds, keeping the name in
+		// the URL for the Host header and SNI
+		tr.Dial = va.dial
+	}
 	client := http.Client{
 		Transport: tr,
 		Timeout:   5 * time.Second,
@@ -128,8 +176,8 @@ func (va ValidationAuthorityImpl) validateSimpleHTTP(identifier core.AcmeIdentif
 func (va ValidationAuthorityImpl) validateDvsni(identifier core.AcmeIdentifier, input core.Challenge) (core.Challenge, error) {
 	challenge := input
 
-	if identifier.Type != "dns" {
-		err := fmt.Errorf("Identifier type for DVSNI was not DNS")
+	if identifier.Type != core.IdentifierDNS && identifier.Type != core.IdentifierIP {
+		err := fmt.Errorf("Identifier type for DVSNI was not DNS or IP")
 		challenge.Status = core.StatusInvalid
 		return challenge, err
 	}
@@ -156,23 +204,34 @@ func (va ValidationAuthorityImpl) validateDvsni(identifier core.AcmeIdentifier,
 
 	// Make a connection with SNI = nonceName
 
-	hostPort := identifier.Value + ":443"
+	hostPort := net.JoinHostPort(identifier.Value, "443")
 	if va.TestMode {
 		hostPort = "localhost:5001"
 	}
 	va.log.Notice(fmt.Sprintf("Attempting to validate DVSNI for %s %s %s",
 		identifier, hostPort, zName))
-	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 5 * time.Second}, "tcp", hostPort, &tls.Config{
-		ServerName:         nonceName,
-		InsecureSkipVerify: true,
-	})
-
+	var rawConn net.Conn
+	if va.TestMode {
+		rawConn, err = net.DialTimeout("tcp", hostPort, validationDialTimeout)
+	} else {
+		rawConn, err = va.dial("tcp", hostPort)
+	}
 	if err != nil {
 		va.log.Debug("Failed to connect to host for DVSNI challenge")
 		challenge.Status = core.StatusInvalid
 		return challenge, err
 	}
+	conn := tls.Client(rawConn, &tls.Config{
+		ServerName:         nonceName,
+		InsecureSkipVerify: true,
+	})
 	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(validationDialTimeout))
+	if err = conn.Handshake(); err != nil {
+		va.log.Debug("Failed TLS handshake with host for DVSNI challenge")
+		challenge.Status = core.StatusInvalid
+		return challenge, err
+	}
 
 	// Check that zName is a dNSName SAN in the server's certificate
 	certs := conn.ConnectionState().PeerCertificates
@@ -193,6 +252,17 @@ func (va ValidationAuthorityImpl) validateDvsni(identifier core.AcmeIdentifier,
 	return challenge, err
 }
 
+// logDNSSECStatus records the result of validating the lookups made for a
+// challenge or CAA check, if there is a validator configured.  Bogus results
+// are refused by the resolver with a DNSSECError.
+func (va ValidationAuthorityImpl) logDNSSECStatus(rrtype, name string, status core.DNSSECStatus) {
+	if status == core.DNSSECUnchecked || status == "" {
+		return
+	}
+	// AUDIT[ Certificate Requests ] 11917fa4-10ef-4e0d-9105-bacbe7836a3c
+	va.log.Audit(fmt.Sprintf("DNSSEC validation of %s records for %s: %s", rrtype, name, status))
+}
+
 func (va ValidationAuthorityImpl) validateDNS(identifier core.AcmeIdentifier, input core.Challenge) (core.Challenge, error) {
 	challenge := input
 
@@ -205,7 +275,8 @@ func (va ValidationAuthorityImpl) validateDNS(identifier core.AcmeIdentifier, in
 	const DNSPrefix = "_acme-challenge"
 
 	challengeSubdomain := fmt.Sprintf("%s.%s", DNSPrefix, identifier.Value)
-	txts, _, err := va.DNSResolver.LookupTXT(challengeSubdomain)
+	txts, status, _, err := va.DNSResolver.LookupTXTStatus(challengeSubdomain)
+	va.logDNSSECStatus("TXT", challengeSubdomain, status)
 
 	if err != nil {
 		challenge.Status = core.StatusInvalid
@@ -276,13 +347,39 @@ func (va ValidationAuthorityImpl) UpdateValidations(authz core.Authorization, ch
 }
 
 // CheckCAARecords verifies that, if the indicated subscriber domain has any CAA
-// records, they authorize the configured CA domain to issue a certificate
-func (va *ValidationAuthorityImpl) CheckCAARecords(identifier core.AcmeIdentifier) (present, valid bool, err error) {
+// records, they authorize one of the configured CA domains to issue a
+// certificate for the given registration. If challengeTypes is non-nil, the
+// records must also permit each of those validation methods. IP address
+// identifiers are checked against their reverse zone if CheckReverseZoneCAA
+// is set, and are otherwise treated as having no CAA records.
+func (va *ValidationAuthorityImpl) CheckCAARecords(identifier core.AcmeIdentifier, regID int64, challengeTypes []string) (present, valid bool, err error) {
 	domain := strings.ToLower(identifier.Value)
-	caaSet, err := getCaaSet(domain, va.DNSResolver)
+	wildcard := false
+	if identifier.Type == core.IdentifierIP {
+		if !va.CheckReverseZoneCAA {
+			present = false
+			valid = true
+			return
+		}
+		domain, err = dns.ReverseAddr(identifier.Value)
+		if err != nil {
+			return
+		}
+	} else if strings.HasPrefix(domain, "*.") {
+		// The relevant RRset for a wildcard is that of the domain it covers
+		wildcard = true
+		domain = domain[2:]
+	}
+	caaSet, status, err := getCaaSet(domain, va.DNSResolver, va.RegisteredDomain)
+	va.logDNSSECStatus("CAA", domain, status)
 	if err != nil {
 		return
 	}
+	defer func() {
+		if present && !valid && va.IodefReporter != nil {
+			go va.IodefReporter.Report(identifier, caaSet.iodef)
+		}
+	}()
 	if caaSet == nil {
 		// No CAA records found, can issue
 		present = false
@@ -292,16 +389,18 @@ func (va *ValidationAuthorityImpl) CheckCAARecords(identifier core.AcmeIdentifie
 		present = true
 		valid = false
 		return
-	} else if len(caaSet.issue) > 0 || len(caaSet.issuewild) > 0 {
+	}
+
+	// issuewild takes precedence over issue for wildcards, if present, and is
+	// ignored for other names (RFC 6844, Section 5.3)
+	checkSet := caaSet.issue
+	if wildcard && len(caaSet.issuewild) > 0 {
+		checkSet = caaSet.issuewild
+	}
+	if len(checkSet) > 0 {
 		present = true
-		var checkSet []*CAA
-		if strings.SplitN(domain, ".", 2)[0] == "*" {
-			checkSet = caaSet.issuewild
-		} else {
-			checkSet = caaSet.issue
-		}
 		for _, caa := range checkSet {
-			if caa.value == va.IssuerDomain {
+			if va.authorizesIssuer(caa, regID, challengeTypes) {
 				valid = true
 				return
 			}
@@ -311,5 +410,57 @@ func (va *ValidationAuthorityImpl) CheckCAARecords(identifier core.AcmeIdentifie
 		return
 	}
 
+	// Only iodef, non-critical unknown properties or, for names that aren't
+	// wildcards, issuewild; these don't restrict issuance
+	present = true
+	valid = true
 	return
 }
+
+// authorizesIssuer returns true if the issue or issuewild record names one of
+// the configured issuer domains, and its parameters permit issuance:
+//
+//   accounturi         the URL of the registration allowed to request issuance
+//   validationmethods  a comma-separated list of the challenge types allowed
+//
+// Unrecognized parameters are ignored.
+func (va *ValidationAuthorityImpl) authorizesIssuer(caa *CAA, regID int64, challengeTypes []string) bool {
+	issuer, params, ok := parseIssueValue(caa.value)
+	if !ok || issuer == "" {
+		return false
+	}
+
+	knownIssuer := false
+	for _, domain := range va.IssuerDomains {
+		if issuer == strings.ToLower(strings.TrimRight(domain, ".")) {
+			knownIssuer = true
+			break
+		}
+	}
+	if !knownIssuer {
+		return false
+	}
+
+	if accountURI, present := params["accounturi"]; present {
+		if accountURI != fmt.Sprintf("%s%d", va.RegBase, regID) {
+			return false
+		}
+	}
+
+	if methods, present := params["validationmethods"]; present && challengeTypes != nil {
+		if len(challengeTypes) == 0 {
+			return false
+		}
+		allowed := map[string]bool{}
+		for _, method := range strings.Split(methods, ",") {
+			allowed[method] = true
+		}
+		for _, challengeType := range challengeTypes {
+			if !allowed[challengeType] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
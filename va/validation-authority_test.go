@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns"
+
 	"github.com/letsencrypt/boulder/core"
 	"github.com/letsencrypt/boulder/test"
 )
@@ -192,9 +194,14 @@ func TestSimpleHttp(t *testing.T) {
 	test.AssertError(t, err, "Empty paths shouldn't work either.")
 
 	chall.Path = "validish"
-	invalidChall, err = va.validateSimpleHTTP(core.AcmeIdentifier{Type: core.IdentifierType("ip"), Value: "127.0.0.1"}, chall)
+	invalidChall, err = va.validateSimpleHTTP(core.AcmeIdentifier{Type: core.IdentifierType("iris"), Value: "127.0.0.1"}, chall)
 	test.AssertEquals(t, invalidChall.Status, core.StatusInvalid)
-	test.AssertError(t, err, "IdentifierType IP shouldn't have worked.")
+	test.AssertError(t, err, "IdentifierType IRIS shouldn't have worked.")
+
+	chall.Path = "test"
+	finChall, err = va.validateSimpleHTTP(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "127.0.0.1"}, chall)
+	test.AssertEquals(t, finChall.Status, core.StatusValid)
+	test.AssertNotError(t, err, "IdentifierType IP should have worked.")
 
 	chall.Path = "wait-long"
 	started := time.Now()
@@ -234,10 +241,15 @@ func TestDvsni(t *testing.T) {
 	test.AssertEquals(t, invalidChall.Status, core.StatusInvalid)
 	test.AssertError(t, err, "R Should be illegal Base64")
 
-	invalidChall, err = va.validateSimpleHTTP(core.AcmeIdentifier{Type: core.IdentifierType("ip"), Value: "127.0.0.1"}, chall)
+	invalidChall, err = va.validateSimpleHTTP(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "127.0.0.1"}, chall)
 	test.AssertEquals(t, invalidChall.Status, core.StatusInvalid)
 	test.AssertError(t, err, "Forgot path; that should be an error.")
 
+	chall.R = ba
+	finChall, err = va.validateDvsni(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "127.0.0.1"}, chall)
+	test.AssertEquals(t, finChall.Status, core.StatusValid)
+	test.AssertNotError(t, err, "IdentifierType IP should have worked.")
+
 	chall.R = ba
 	chall.S = "!@#"
 	invalidChall, err = va.validateDvsni(ident, chall)
@@ -425,7 +437,7 @@ func TestCAAChecking(t *testing.T) {
 	va := NewValidationAuthorityImpl(true)
 	va.DNSResolver = core.NewDNSResolver(time.Second*5, []string{"8.8.8.8:53"})
 	for _, caaTest := range tests {
-		present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: "dns", Value: caaTest.Domain})
+		present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: "dns", Value: caaTest.Domain}, 1, nil)
 		// Ignore tests if DNS req has timed out
 		if err != nil && err.Error() == "read udp 8.8.8.8:53: i/o timeout" {
 			continue
@@ -436,12 +448,119 @@ func TestCAAChecking(t *testing.T) {
 		test.AssertEquals(t, caaTest.Valid, valid)
 	}
 
-	present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: "dns", Value: "dnssec-failed.org"})
+	present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: "dns", Value: "dnssec-failed.org"}, 1, nil)
 	test.AssertError(t, err, "dnssec-failed.org")
 	test.Assert(t, !present, "Present should be false")
 	test.Assert(t, !valid, "Valid should be false")
 }
 
+func TestCAACheckingIP(t *testing.T) {
+	va := NewValidationAuthorityImpl(true)
+	va.DNSResolver = core.NewDNSResolver(time.Second*5, []string{"8.8.8.8:53"})
+
+	// With reverse zone checking disabled, IP identifiers have no CAA
+	present, valid, err := va.CheckCAARecords(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "8.8.8.8"}, 1, nil)
+	test.AssertNotError(t, err, "CAA check for IP identifier failed")
+	test.Assert(t, !present, "Present should be false")
+	test.Assert(t, valid, "Valid should be true")
+
+	va.CheckReverseZoneCAA = true
+	_, _, err = va.CheckCAARecords(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "not-an-ip"}, 1, nil)
+	test.AssertError(t, err, "Malformed IP identifier should not have a reverse zone")
+}
+
+func TestURLHost(t *testing.T) {
+	test.AssertEquals(t, urlHost(core.AcmeIdentifier{Type: core.IdentifierDNS, Value: "example.com"}), "example.com")
+	test.AssertEquals(t, urlHost(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "8.8.8.8"}), "8.8.8.8")
+	test.AssertEquals(t, urlHost(core.AcmeIdentifier{Type: core.IdentifierIP, Value: "2001:4860:4860::8888"}), "[2001:4860:4860::8888]")
+}
+
+var hostZone = []dns.RR{
+	&dns.A{Hdr: dns.RR_Header{Name: "public.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("93.184.216.34")},
+	&dns.AAAA{Hdr: dns.RR_Header{Name: "public.example.com.", Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 60}, AAAA: net.ParseIP("2606:2800:220:1::1")},
+	&dns.A{Hdr: dns.RR_Header{Name: "mixed.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("10.0.0.1")},
+	&dns.A{Hdr: dns.RR_Header{Name: "mixed.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("93.184.216.34")},
+	&dns.A{Hdr: dns.RR_Header{Name: "private.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("192.168.1.1")},
+	&dns.AAAA{Hdr: dns.RR_Header{Name: "private.example.com.", Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: 60}, AAAA: net.ParseIP("fe80::1")},
+	&dns.A{Hdr: dns.RR_Header{Name: "localhost.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 60}, A: net.ParseIP("127.0.0.1")},
+}
+
+// serveHostZone answers queries from hostZone on a local port, returning a
+// resolver that uses it and a function that stops the server.
+func serveHostZone(t *testing.T) (*core.DNSResolver, func()) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen for DNS")
+	server := &dns.Server{PacketConn: pc, ReadTimeout: 100 * time.Millisecond, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(r)
+		for _, rr := range hostZone {
+			if len(r.Question) == 1 && rr.Header().Name == r.Question[0].Name && rr.Header().Rrtype == r.Question[0].Qtype {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+		w.WriteMsg(m)
+	})}
+	go server.ActivateAndServe()
+	return core.NewDNSResolver(time.Second, []string{pc.LocalAddr().String()}), func() { server.Shutdown() }
+}
+
+func TestResolveHost(t *testing.T) {
+	resolver, shutdown := serveHostZone(t)
+	defer shutdown()
+
+	va := NewValidationAuthorityImpl(false)
+	va.DNSResolver = resolver
+
+	// IPv6 addresses come first
+	addrs, err := va.resolveHost("public.example.com")
+	test.AssertNotError(t, err, "Failed to resolve public.example.com")
+	test.AssertEquals(t, len(addrs), 2)
+	test.AssertEquals(t, addrs[0].String(), "2606:2800:220:1::1")
+	test.AssertEquals(t, addrs[1].String(), "93.184.216.34")
+
+	// Reserved addresses are dropped
+	addrs, err = va.resolveHost("mixed.example.com")
+	test.AssertNotError(t, err, "Failed to resolve mixed.example.com")
+	test.AssertEquals(t, len(addrs), 1)
+	test.AssertEquals(t, addrs[0].String(), "93.184.216.34")
+
+	for _, host := range []string{"private.example.com", "localhost.example.com", "missing.example.com", "127.0.0.1", "::1", "::ffff:10.1.2.3", "169.254.169.254"} {
+		_, err = va.resolveHost(host)
+		test.AssertError(t, err, "Resolved unusable host "+host)
+	}
+	addrs, err = va.resolveHost("2606:2800:220:1::1")
+	test.AssertNotError(t, err, "Failed to use an address literal")
+	test.AssertEquals(t, len(addrs), 1)
+
+	// The deny-list is configurable
+	va.ReservedRanges, err = ParseAddressRanges([]string{"93.184.216.0/24"})
+	test.AssertNotError(t, err, "Failed to parse address ranges")
+	addrs, err = va.resolveHost("localhost.example.com")
+	test.AssertNotError(t, err, "Failed to resolve localhost.example.com")
+	_, err = va.resolveHost("93.184.216.34")
+	test.AssertError(t, err, "Connected to a configured reserved range")
+
+	_, err = ParseAddressRanges([]string{"10.0.0.0/33"})
+	test.AssertError(t, err, "Parsed a bad address range")
+}
+
+func TestDialAddrs(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	test.AssertNotError(t, err, "Failed to listen")
+	defer l.Close()
+	_, port, _ := net.SplitHostPort(l.Addr().String())
+
+	// The first address refuses the connection, so the next is tried
+	va := NewValidationAuthorityImpl(false)
+	conn, err := va.dialAddrs([]net.IP{net.ParseIP("127.0.0.2"), net.ParseIP("127.0.0.1")}, port)
+	test.AssertNotError(t, err, "Didn't fall back to the second address")
+	test.AssertEquals(t, conn.RemoteAddr().String(), l.Addr().String())
+	conn.Close()
+
+	_, err = va.dialAddrs(nil, port)
+	test.AssertError(t, err, "Connected without any addresses")
+}
+
 func TestDNSValidationFailure(t *testing.T) {
 	va := NewValidationAuthorityImpl(true)
 	va.DNSResolver = core.NewDNSResolver(time.Second*5, []string{"8.8.8.8:53"})
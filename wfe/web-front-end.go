@@ -20,27 +20,15 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/cactus/go-statsd-client/statsd"
+	"github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/miekg/dns/idn"
 	jose "github.com/letsencrypt/boulder/Godeps/_workspace/src/github.com/square/go-jose"
 	"github.com/letsencrypt/boulder/core"
 	blog "github.com/letsencrypt/boulder/log"
 )
 
-// Paths are the ACME-spec identified URL path-segments for various methods
-const (
-	NewRegPath     = "/acme/new-reg"
-	RegPath        = "/acme/reg/"
-	NewAuthzPath   = "/acme/new-authz"
-	AuthzPath      = "/acme/authz/"
-	NewCertPath    = "/acme/new-cert"
-	CertPath       = "/acme/cert/"
-	RevokeCertPath = "/acme/revoke-cert"
-	TermsPath      = "/terms"
-	IssuerPath     = "/acme/issuer-cert"
-	BuildIDPath    = "/build"
-)
-
 // WebFrontEndImpl represents a Boulder web service and its resources
 type WebFrontEndImpl struct {
 	RA    core.RegistrationAuthority
@@ -60,6 +48,10 @@ type WebFrontEndImpl struct {
 	// Issuer certificate (DER) for /acme/issuer-cert
 	IssuerCert []byte
 
+	// Certificates (DER) of all the CA's issuers by name, each served at
+	// /acme/issuer-cert/<name>
+	Issuers map[string][]byte
+
 	// URL to the current subscriber agreement (should contain some version identifier)
 	SubscriberAgreementURL string
 
@@ -103,24 +95,25 @@ func NewWebFrontEndImpl() WebFrontEndImpl {
 // HandlePaths configures the HTTP engine to use various functions
 // as methods for various ACME-specified paths.
 func (wfe *WebFrontEndImpl) HandlePaths() {
-	wfe.NewReg = wfe.BaseURL + NewRegPath
-	wfe.RegBase = wfe.BaseURL + RegPath
-	wfe.NewAuthz = wfe.BaseURL + NewAuthzPath
-	wfe.AuthzBase = wfe.BaseURL + AuthzPath
-	wfe.NewCert = wfe.BaseURL + NewCertPath
-	wfe.CertBase = wfe.BaseURL + CertPath
+	wfe.NewReg = wfe.BaseURL + core.NewRegPath
+	wfe.RegBase = wfe.BaseURL + core.RegPath
+	wfe.NewAuthz = wfe.BaseURL + core.NewAuthzPath
+	wfe.AuthzBase = wfe.BaseURL + core.AuthzPath
+	wfe.NewCert = wfe.BaseURL + core.NewCertPath
+	wfe.CertBase = wfe.BaseURL + core.CertPath
 
 	http.HandleFunc("/", wfe.Index)
-	http.HandleFunc(NewRegPath, wfe.NewRegistration)
-	http.HandleFunc(NewAuthzPath, wfe.NewAuthorization)
-	http.HandleFunc(NewCertPath, wfe.NewCertificate)
-	http.HandleFunc(RegPath, wfe.Registration)
-	http.HandleFunc(AuthzPath, wfe.Authorization)
-	http.HandleFunc(CertPath, wfe.Certificate)
-	http.HandleFunc(RevokeCertPath, wfe.RevokeCertificate)
-	http.HandleFunc(TermsPath, wfe.Terms)
-	http.HandleFunc(IssuerPath, wfe.Issuer)
-	http.HandleFunc(BuildIDPath, wfe.BuildID)
+	http.HandleFunc(core.NewRegPath, wfe.NewRegistration)
+	http.HandleFunc(core.NewAuthzPath, wfe.NewAuthorization)
+	http.HandleFunc(core.NewCertPath, wfe.NewCertificate)
+	http.HandleFunc(core.RegPath, wfe.Registration)
+	http.HandleFunc(core.AuthzPath, wfe.Authorization)
+	http.HandleFunc(core.CertPath, wfe.Certificate)
+	http.HandleFunc(core.RevokeCertPath, wfe.RevokeCertificate)
+	http.HandleFunc(core.TermsPath, wfe.Terms)
+	http.HandleFunc(core.IssuerPath, wfe.Issuer)
+	http.HandleFunc(core.IssuerPath+"/", wfe.Issuer)
+	http.HandleFunc(core.BuildIDPath, wfe.BuildID)
 }
 
 // Method implementations
@@ -161,6 +154,16 @@ func parseIDFromPath(path string) string {
 	return re.ReplaceAllString(path, "")
 }
 
+// isASCII returns true if the string contains only ASCII characters
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
 // ProblemType objects represent problem documents, which are
 // returned with HTTP error responses
 // https://tools.ietf.org/html/draft-ietf-appsawg-http-problem-00
@@ -394,6 +397,12 @@ func (wfe *WebFrontEndImpl) NewAuthorization(response http.ResponseWriter, reque
 		return
 	}
 
+	// Accept Unicode domain names by converting them to A-labels; the policy
+	// authority decides whether the resulting labels are acceptable.
+	if init.Identifier.Type == core.IdentifierDNS && !isASCII(init.Identifier.Value) {
+		init.Identifier.Value = idn.ToPunycode(strings.ToLower(init.Identifier.Value))
+	}
+
 	// Create new authz and return
 	authz, err := wfe.RA.NewAuthorization(init, currReg.ID)
 	if err != nil {
@@ -401,10 +410,12 @@ func (wfe *WebFrontEndImpl) NewAuthorization(response http.ResponseWriter, reque
 		return
 	}
 
-	// Make a URL for this authz, then blow away the ID and RegID before serializing
+	// Make a URL for this authz, then blow away the ID, RegID, and CAA check
+	// time before serializing
 	authzURL := wfe.AuthzBase + string(authz.ID)
 	authz.ID = ""
 	authz.RegistrationID = 0
+	authz.CAAChecked = nil
 	responseBody, err := json.Marshal(authz)
 	if err != nil {
 		// StatusInternalServerError because we generated the authz, it should be OK
@@ -564,12 +575,11 @@ func (wfe *WebFrontEndImpl) NewCertificate(response http.ResponseWriter, request
 			http.StatusBadRequest)
 		return
 	}
-	serial := parsedCertificate.SerialNumber
-	certURL := fmt.Sprintf("%s%016x", wfe.CertBase, serial.Rsh(serial, 64))
+	certURL := wfe.CertBase + core.ShortSerial(parsedCertificate.SerialNumber)
 
 	// TODO Content negotiation
 	response.Header().Add("Location", certURL)
-	response.Header().Add("Link", link(wfe.BaseURL+IssuerPath, "up"))
+	response.Header().Add("Link", link(wfe.issuerURL(parsedCertificate), "up"))
 	response.Header().Set("Content-Type", "application/pkix-cert")
 	response.WriteHeader(http.StatusCreated)
 	if _, err = response.Write(cert.DER); err != nil {
@@ -803,9 +813,10 @@ func (wfe *WebFrontEndImpl) Authorization(response http.ResponseWriter, request
 		return
 
 	case "GET":
-		// Blank out ID and regID
+		// Blank out ID, regID, and CAA check time
 		authz.ID = ""
 		authz.RegistrationID = 0
+		authz.CAAChecked = nil
 
 		jsonReply, err := json.Marshal(authz)
 		if err != nil {
@@ -843,14 +854,14 @@ func (wfe *WebFrontEndImpl) Certificate(response http.ResponseWriter, request *h
 		return
 
 	case "GET":
-		// Certificate paths consist of the CertBase path, plus exactly sixteen hex
-		// digits.
-		if !strings.HasPrefix(path, CertPath) {
+		// Certificate paths consist of the CertBase path, plus the short serial:
+		// sixteen hex digits for sequential serials, eighteen for random ones.
+		if !strings.HasPrefix(path, core.CertPath) {
 			wfe.sendError(response, "Not found", path, http.StatusNotFound)
 			return
 		}
-		serial := path[len(CertPath):]
-		if len(serial) != 16 || !allHex.Match([]byte(serial)) {
+		serial := path[len(core.CertPath):]
+		if !core.ValidShortSerial(serial) || !allHex.Match([]byte(serial)) {
 			wfe.sendError(response, "Not found", serial, http.StatusNotFound)
 			return
 		}
@@ -868,7 +879,11 @@ func (wfe *WebFrontEndImpl) Certificate(response http.ResponseWriter, request *h
 
 		// TODO Content negotiation
 		response.Header().Set("Content-Type", "application/pkix-cert")
-		response.Header().Add("Link", link(IssuerPath, "up"))
+		if parsedCertificate, err := x509.ParseCertificate(cert.DER); err == nil {
+			response.Header().Add("Link", link(wfe.issuerURL(parsedCertificate), "up"))
+		} else {
+			response.Header().Add("Link", link(core.IssuerPath, "up"))
+		}
 		response.WriteHeader(http.StatusOK)
 		if _, err = response.Write(cert.DER); err != nil {
 			wfe.log.Warning(fmt.Sprintf("Could not write response: %s", err))
@@ -894,7 +909,25 @@ func (wfe *WebFrontEndImpl) Terms(response http.ResponseWriter, request *http.Re
 	fmt.Fprintf(response, "TODO: Add terms of use here")
 }
 
-// Issuer obtains the issuer certificate used by this instance of Boulder.
+// issuerURL finds the URL of the issuer certificate of a certificate among
+// the CA's issuers, defaulting to /acme/issuer-cert.
+func (wfe *WebFrontEndImpl) issuerURL(cert *x509.Certificate) string {
+	for name, der := range wfe.Issuers {
+		issuer, err := x509.ParseCertificate(der)
+		if err != nil || !bytes.Equal(cert.RawIssuer, issuer.RawSubject) {
+			continue
+		}
+		if len(cert.AuthorityKeyId) > 0 && len(issuer.SubjectKeyId) > 0 &&
+			!bytes.Equal(cert.AuthorityKeyId, issuer.SubjectKeyId) {
+			continue
+		}
+		return wfe.BaseURL + core.IssuerPath + "/" + name
+	}
+	return wfe.BaseURL + core.IssuerPath
+}
+
+// Issuer obtains the issuer certificate used by this instance of Boulder, or
+// one of the CA's issuers by name.
 func (wfe *WebFrontEndImpl) Issuer(response http.ResponseWriter, request *http.Request) {
 	wfe.sendStandardHeaders(response)
 
@@ -904,10 +937,20 @@ func (wfe *WebFrontEndImpl) Issuer(response http.ResponseWriter, request *http.R
 		return
 	}
 
+	issuerCert := wfe.IssuerCert
+	if strings.HasPrefix(request.URL.Path, core.IssuerPath+"/") {
+		name := request.URL.Path[len(core.IssuerPath)+1:]
+		var present bool
+		if issuerCert, present = wfe.Issuers[name]; !present {
+			wfe.sendError(response, "Not found", name, http.StatusNotFound)
+			return
+		}
+	}
+
 	// TODO Content negotiation
 	response.Header().Set("Content-Type", "application/pkix-cert")
 	response.WriteHeader(http.StatusOK)
-	if _, err := response.Write(wfe.IssuerCert); err != nil {
+	if _, err := response.Write(issuerCert); err != nil {
 		wfe.log.Warning(fmt.Sprintf("Could not write response: %s", err))
 	}
 }
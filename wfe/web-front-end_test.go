@@ -6,8 +6,10 @@
 package wfe
 
 import (
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
@@ -15,6 +17,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -212,11 +215,19 @@ func (sa *MockSA) GetCertificateStatus(serial string) (core.CertificateStatus, e
 	}
 }
 
+func (sa *MockSA) GetSCTReceipts(serial string) ([]core.SignedCertificateTimestamp, error) {
+	return nil, nil
+}
+
 func (sa *MockSA) AlreadyDeniedCSR([]string) (bool, error) {
 	return false, nil
 }
 
-func (sa *MockSA) AddCertificate(certDER []byte, regID int64) (digest string, err error) {
+func (sa *MockSA) AddCertificate(certDER []byte, regID int64, profile string) (digest string, err error) {
+	return
+}
+
+func (sa *MockSA) AddSCTReceipt(sct core.SignedCertificateTimestamp) (err error) {
 	return
 }
 
@@ -282,7 +293,7 @@ func (ra *MockRegistrationAuthority) OnValidationUpdate(authz core.Authorization
 
 type MockCA struct{}
 
-func (ca *MockCA) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time) (cert core.Certificate, err error) {
+func (ca *MockCA) IssueCertificate(csr x509.CertificateRequest, regID int64, earliestExpiry time.Time, profile string) (cert core.Certificate, err error) {
 	// Return a basic certificate so NewCertificate can continue
 	randomCertDer, _ := hex.DecodeString(GoodTestCert)
 	cert.DER = randomCertDer
@@ -293,10 +304,24 @@ func (ca *MockCA) GenerateOCSP(xferObj core.OCSPSigningRequest) (ocsp []byte, er
 	return
 }
 
+func (ca *MockCA) GenerateCRL(xferObj core.CRLSigningRequest) (crl []byte, err error) {
+	return
+}
+
 func (ca *MockCA) RevokeCertificate(serial string, reasonCode int) (err error) {
 	return
 }
 
+type MockVA struct{}
+
+func (va *MockVA) UpdateValidations(authz core.Authorization, index int) error {
+	return nil
+}
+
+func (va *MockVA) CheckCAARecords(identifier core.AcmeIdentifier, regID int64, challengeTypes []string) (present, valid bool, err error) {
+	return false, true, nil
+}
+
 func makeBody(s string) io.ReadCloser {
 	return ioutil.NopCloser(strings.NewReader(s))
 }
@@ -317,12 +342,12 @@ func signRequest(t *testing.T, req string, nonceService *core.NonceService) stri
 func setupWFE() WebFrontEndImpl {
 	wfe := NewWebFrontEndImpl()
 
-	wfe.NewReg = wfe.BaseURL + NewRegPath
-	wfe.RegBase = wfe.BaseURL + RegPath
-	wfe.NewAuthz = wfe.BaseURL + NewAuthzPath
-	wfe.AuthzBase = wfe.BaseURL + AuthzPath
-	wfe.NewCert = wfe.BaseURL + NewCertPath
-	wfe.CertBase = wfe.BaseURL + CertPath
+	wfe.NewReg = wfe.BaseURL + core.NewRegPath
+	wfe.RegBase = wfe.BaseURL + core.RegPath
+	wfe.NewAuthz = wfe.BaseURL + core.NewAuthzPath
+	wfe.AuthzBase = wfe.BaseURL + core.AuthzPath
+	wfe.NewCert = wfe.BaseURL + core.NewCertPath
+	wfe.CertBase = wfe.BaseURL + core.CertPath
 	wfe.SubscriberAgreementURL = agreementURL
 
 	return wfe
@@ -387,6 +412,75 @@ func TestIndex(t *testing.T) {
 	test.AssertEquals(t, responseWriter.Body.String(), "404 page not found\n")
 }
 
+func TestIssuer(t *testing.T) {
+	wfe := setupWFE()
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	test.AssertNotError(t, err, "Failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test issuer"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	issuerDER, err := x509.CreateCertificate(rand.Reader, template, template, priv.Public(), priv)
+	test.AssertNotError(t, err, "Failed to create certificate")
+	issuerCert, _ := x509.ParseCertificate(issuerDER)
+
+	wfe.IssuerCert = []byte("default issuer")
+	wfe.Issuers = map[string][]byte{"test": issuerDER}
+
+	for _, c := range []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/acme/issuer-cert", http.StatusOK, "default issuer"},
+		{"/acme/issuer-cert/test", http.StatusOK, string(issuerDER)},
+		{"/acme/issuer-cert/unknown", http.StatusNotFound, ""},
+	} {
+		responseWriter := httptest.NewRecorder()
+		url, _ := url.Parse(c.path)
+		wfe.Issuer(responseWriter, &http.Request{
+			Method: "GET",
+			URL:    url,
+		})
+		test.AssertEquals(t, responseWriter.Code, c.code)
+		if c.code == http.StatusOK {
+			test.AssertEquals(t, responseWriter.Body.String(), c.body)
+		}
+	}
+
+	// Certificates link to their own issuer when it is known
+	test.AssertEquals(t, wfe.issuerURL(issuerCert), "/acme/issuer-cert/test")
+	wfe.Issuers = nil
+	test.AssertEquals(t, wfe.issuerURL(issuerCert), "/acme/issuer-cert")
+}
+
+func TestCertificate(t *testing.T) {
+	wfe := setupWFE()
+	wfe.SA = &MockSA{}
+
+	for _, c := range []struct {
+		path string
+		code int
+	}{
+		{"/acme/cert/0000000000000000", http.StatusOK},
+		{"/acme/cert/ff0000000000000002", http.StatusOK},
+		{"/acme/cert/ff000000000000000", http.StatusNotFound},
+		{"/acme/cert/ff00000000000000023", http.StatusNotFound},
+		{"/acme/cert/FF0000000000000002", http.StatusNotFound},
+	} {
+		responseWriter := httptest.NewRecorder()
+		url, _ := url.Parse(c.path)
+		wfe.Certificate(responseWriter, &http.Request{
+			Method: "GET",
+			URL:    url,
+		})
+		test.AssertEquals(t, responseWriter.Code, c.code)
+	}
+}
+
 // TODO: Write additional test cases for:
 //  - RA returns with a failure
 func TestIssueCertificate(t *testing.T) {
@@ -396,6 +490,7 @@ func TestIssueCertificate(t *testing.T) {
 	ra := ra.NewRegistrationAuthorityImpl()
 	ra.SA = &MockSA{}
 	ra.CA = &MockCA{}
+	ra.VA = &MockVA{}
 	wfe.SA = &MockSA{}
 	wfe.RA = &ra
 	wfe.Stats, _ = statsd.NewNoopClient()
@@ -874,6 +969,14 @@ func TestAuthorization(t *testing.T) {
 	var authz core.Authorization
 	err := json.Unmarshal([]byte(responseWriter.Body.String()), &authz)
 	test.AssertNotError(t, err, "Couldn't unmarshal returned authorization object")
+
+	// Unicode identifiers are converted to A-labels
+	responseWriter = httptest.NewRecorder()
+	wfe.NewAuthorization(responseWriter, &http.Request{
+		Method: "POST",
+		Body:   makeBody(signRequest(t, "{\"identifier\":{\"type\":\"dns\",\"value\":\"www.B\u00fccher.com\"}}", &wfe.nonceService)),
+	})
+	test.AssertEquals(t, responseWriter.Body.String(), "{\"identifier\":{\"type\":\"dns\",\"value\":\"www.xn--bcher-kva.com\"}}")
 }
 
 func TestRegistration(t *testing.T) {